@@ -0,0 +1,310 @@
+package negroni
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// DefaultBufferLimit is the number of body bytes the Buffer middleware holds
+// in memory before falling back to streaming.
+const DefaultBufferLimit = 1 << 20
+
+// BufferedResponseWriter is a ResponseWriter that holds the status, headers
+// and body of a response in memory instead of sending them, so middleware can
+// inspect and replace them, e.g. to substitute error pages, sign responses or
+// compute ETags. The response is sent to the underlying ResponseWriter on
+// Commit, or as soon as the body grows past the limit or the response is
+// flushed, after which further writes are streamed.
+//
+// Before and After callbacks are registered on the underlying ResponseWriter,
+// so Before callbacks run when the response is committed. It implements
+// MetricsResponseWriter, TeeResponseWriter, TrailerResponseWriter,
+// HijackedResponseWriter and InformationalResponseWriter, reporting the
+// response as written by the handlers even while it is buffered, so they can
+// be logged by a Logger placed after Buffer.
+//
+// The Buffer middleware hands its handlers a ResponseWriter implementing the
+// optional interfaces of the underlying one, such as http.Flusher or
+// http.Hijacker, which commit the response when it must be sent. The
+// BufferedResponseWriter is retrieved from it with GetBufferedResponseWriter.
+type BufferedResponseWriter struct {
+	ResponseWriter
+
+	limit     int
+	header    http.Header
+	status    int
+	body      bytes.Buffer
+	committed bool
+	metrics   ResponseMetrics
+	tee       io.Writer
+}
+
+// NewBufferedResponseWriter returns a BufferedResponseWriter buffering up to
+// limit bytes of body in front of rw.
+func NewBufferedResponseWriter(rw http.ResponseWriter, limit int) *BufferedResponseWriter {
+	return &BufferedResponseWriter{
+		ResponseWriter: NewResponseWriter(rw),
+		limit:          limit,
+		header:         rw.Header().Clone(),
+	}
+}
+
+// Header returns the buffered headers, or the headers of the underlying
+// ResponseWriter once the response has been committed.
+func (b *BufferedResponseWriter) Header() http.Header {
+	if b.committed {
+		return b.ResponseWriter.Header()
+	}
+	return b.header
+}
+
+// WriteHeader records the status code of the response. Informational 1xx
+// responses other than 101 Switching Protocols are sent right away, and 101
+// Switching Protocols commits the response.
+func (b *BufferedResponseWriter) WriteHeader(s int) {
+	switch {
+	case b.committed:
+		b.ResponseWriter.WriteHeader(s)
+	case s == http.StatusSwitchingProtocols:
+		b.status = s
+		b.Commit()
+	case s >= 100 && s < 200:
+		b.copyHeader()
+		b.ResponseWriter.WriteHeader(s)
+	case b.status == 0:
+		b.status = s
+	}
+}
+
+// Write buffers the body, or commits the response and streams the body if it
+// would grow past the limit.
+func (b *BufferedResponseWriter) Write(p []byte) (int, error) {
+	n, err := b.write(p)
+	b.metrics.recordWrite(n)
+	if b.tee != nil {
+		b.tee.Write(p[:n])
+	}
+	return n, err
+}
+
+func (b *BufferedResponseWriter) write(p []byte) (int, error) {
+	if b.committed {
+		return b.ResponseWriter.Write(p)
+	}
+	if b.status == 0 {
+		// The status will be StatusOK if WriteHeader has not been called yet
+		b.status = http.StatusOK
+	}
+	if b.body.Len()+len(p) > b.limit {
+		if err := b.Commit(); err != nil {
+			return 0, err
+		}
+		return b.ResponseWriter.Write(p)
+	}
+	return b.body.Write(p)
+}
+
+// Unwrap commits the response and returns the underlying ResponseWriter, as
+// writing to it directly would bypass the buffer.
+func (b *BufferedResponseWriter) Unwrap() http.ResponseWriter {
+	b.Commit()
+	return b.ResponseWriter
+}
+
+func (b *BufferedResponseWriter) Status() int {
+	if b.committed {
+		return b.ResponseWriter.Status()
+	}
+	return b.status
+}
+
+func (b *BufferedResponseWriter) Written() bool {
+	if b.committed {
+		return b.ResponseWriter.Written()
+	}
+	return b.status != 0
+}
+
+func (b *BufferedResponseWriter) Size() int {
+	if b.committed {
+		return b.ResponseWriter.Size()
+	}
+	return b.body.Len()
+}
+
+// Metrics returns the metrics of the underlying ResponseWriter, except for the
+// writes, which are those made to b whether they were buffered or not, and
+// the trailers, which are counted in the buffered headers until the response
+// is committed.
+func (b *BufferedResponseWriter) Metrics() ResponseMetrics {
+	var metrics ResponseMetrics
+	if m, ok := b.ResponseWriter.(MetricsResponseWriter); ok {
+		metrics = m.Metrics()
+	}
+	metrics.FirstByteAt = b.metrics.FirstByteAt
+	metrics.LastWriteAt = b.metrics.LastWriteAt
+	metrics.Writes = b.metrics.Writes
+	if !b.committed {
+		metrics.TrailerBytes = fieldBytes(b.Trailer())
+	}
+	return metrics
+}
+
+// Tee mirrors the body written to b from now on to w, as it is written by
+// the handlers rather than once the response is committed, so any body set
+// with SetBody is not mirrored.
+func (b *BufferedResponseWriter) Tee(w io.Writer) {
+	w = teeWriter{w}
+	if b.tee != nil {
+		w = io.MultiWriter(b.tee, w)
+	}
+	b.tee = w
+}
+
+// Trailer returns the trailers set so far in the buffered headers, or those
+// of the underlying ResponseWriter once the response has been committed.
+func (b *BufferedResponseWriter) Trailer() http.Header {
+	if !b.committed {
+		return trailers(b.header)
+	}
+	if t, ok := b.ResponseWriter.(TrailerResponseWriter); ok {
+		return t.Trailer()
+	}
+	return trailers(b.ResponseWriter.Header())
+}
+
+func (b *BufferedResponseWriter) Hijacked() bool {
+	h, ok := b.ResponseWriter.(HijackedResponseWriter)
+	return ok && h.Hijacked()
+}
+
+func (b *BufferedResponseWriter) HijackInfo() HijackInfo {
+	if h, ok := b.ResponseWriter.(HijackedResponseWriter); ok {
+		return h.HijackInfo()
+	}
+	return HijackInfo{}
+}
+
+func (b *BufferedResponseWriter) Informational() []InformationalResponse {
+	if i, ok := b.ResponseWriter.(InformationalResponseWriter); ok {
+		return i.Informational()
+	}
+	return nil
+}
+
+// Buffered returns whether the response is still held in memory, i.e. it has
+// not been committed yet.
+func (b *BufferedResponseWriter) Buffered() bool {
+	return !b.committed
+}
+
+// Body returns the buffered body. It is only valid until the next write.
+func (b *BufferedResponseWriter) Body() []byte {
+	return b.body.Bytes()
+}
+
+// SetStatus replaces the buffered status code. It has no effect once the
+// response has been committed.
+func (b *BufferedResponseWriter) SetStatus(s int) {
+	if !b.committed {
+		b.status = s
+	}
+}
+
+// SetBody replaces the buffered body and removes any Content-Length header,
+// which may no longer match. It has no effect once the response has been
+// committed.
+func (b *BufferedResponseWriter) SetBody(p []byte) {
+	if b.committed {
+		return
+	}
+	b.body.Reset()
+	b.body.Write(p)
+	b.header.Del("Content-Length")
+}
+
+// Reset discards the buffered status and body, leaving the headers untouched.
+// It has no effect once the response has been committed.
+func (b *BufferedResponseWriter) Reset() {
+	if !b.committed {
+		b.status = 0
+		b.body.Reset()
+	}
+}
+
+// Commit sends the buffered status, headers and body to the underlying
+// ResponseWriter. Calling Commit again has no effect.
+func (b *BufferedResponseWriter) Commit() error {
+	if b.committed {
+		return nil
+	}
+	b.committed = true
+
+	b.copyHeader()
+	if b.status != 0 {
+		b.ResponseWriter.WriteHeader(b.status)
+	}
+	if b.body.Len() == 0 {
+		return nil
+	}
+	_, err := b.ResponseWriter.Write(b.body.Bytes())
+	b.body.Reset()
+	return err
+}
+
+// copyHeader replaces the headers of the underlying ResponseWriter with the
+// buffered ones.
+func (b *BufferedResponseWriter) copyHeader() {
+	dst := b.ResponseWriter.Header()
+	for key := range dst {
+		if _, ok := b.header[key]; !ok {
+			delete(dst, key)
+		}
+	}
+	for key, values := range b.header {
+		dst[key] = append([]string(nil), values...)
+	}
+}
+
+// GetBufferedResponseWriter returns the BufferedResponseWriter buffering the
+// response written to rw, if rw is one or was handed out by the Buffer
+// middleware.
+func GetBufferedResponseWriter(rw http.ResponseWriter) (*BufferedResponseWriter, bool) {
+	b, ok := rw.(interface {
+		bufferedResponseWriter() *BufferedResponseWriter
+	})
+	if !ok {
+		return nil, false
+	}
+	return b.bufferedResponseWriter(), true
+}
+
+func (b *BufferedResponseWriter) bufferedResponseWriter() *BufferedResponseWriter {
+	return b
+}
+
+func (b *BufferedResponseWriter) callsAfter() bool {
+	return callsAfter(b.ResponseWriter)
+}
+
+// Buffer is a Negroni middleware that buffers the responses of the handlers
+// after it in a BufferedResponseWriter, which they can retrieve with
+// GetBufferedResponseWriter to inspect or replace the response before it is
+// committed.
+type Buffer struct {
+	// Limit is the number of body bytes held in memory before falling back
+	// to streaming.
+	Limit int
+}
+
+// NewBuffer returns a new instance of Buffer
+func NewBuffer() *Buffer {
+	return &Buffer{Limit: DefaultBufferLimit}
+}
+
+func (b *Buffer) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	brw := NewBufferedResponseWriter(rw, b.Limit)
+	next(wrapBufferedFeature(brw), r)
+	brw.Commit()
+}
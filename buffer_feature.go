@@ -0,0 +1,85 @@
+package negroni
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+type (
+	bufferedFlusherFeature       struct{ *BufferedResponseWriter }
+	bufferedHijackerFeature      struct{ *BufferedResponseWriter }
+	bufferedCloseNotifierFeature struct{ *BufferedResponseWriter }
+	bufferedPusherFeature        struct{ *BufferedResponseWriter }
+	bufferedStringWriterFeature  struct{ *BufferedResponseWriter }
+	bufferedFlushErrorFeature    struct{ *BufferedResponseWriter }
+	bufferedReadDeadlineFeature  struct{ *BufferedResponseWriter }
+	bufferedWriteDeadlineFeature struct{ *BufferedResponseWriter }
+	bufferedFullDuplexFeature    struct{ *BufferedResponseWriter }
+)
+
+func (f bufferedFlusherFeature) Flush() {
+	f.Commit()
+	f.ResponseWriter.(http.Flusher).Flush()
+}
+
+// Hijack commits the response first, as net/http sends a response that was
+// already written before handing over the connection.
+func (f bufferedHijackerFeature) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if err := f.Commit(); err != nil {
+		return nil, nil, err
+	}
+	return f.ResponseWriter.(http.Hijacker).Hijack()
+}
+
+func (f bufferedCloseNotifierFeature) CloseNotify() <-chan bool {
+	return f.ResponseWriter.(http.CloseNotifier).CloseNotify()
+}
+
+func (f bufferedPusherFeature) Push(target string, opts *http.PushOptions) error {
+	return f.ResponseWriter.(http.Pusher).Push(target, opts)
+}
+
+func (f bufferedStringWriterFeature) WriteString(s string) (int, error) {
+	if !f.committed {
+		return f.Write([]byte(s))
+	}
+	n, err := f.ResponseWriter.(io.StringWriter).WriteString(s)
+	f.metrics.recordWrite(n)
+	if f.tee != nil {
+		io.WriteString(f.tee, s[:n])
+	}
+	return n, err
+}
+
+func (f bufferedFlushErrorFeature) FlushError() error {
+	if err := f.Commit(); err != nil {
+		return err
+	}
+	return f.ResponseWriter.(errorFlusher).FlushError()
+}
+
+func (f bufferedReadDeadlineFeature) SetReadDeadline(deadline time.Time) error {
+	return f.ResponseWriter.(readDeadlineSetter).SetReadDeadline(deadline)
+}
+
+func (f bufferedWriteDeadlineFeature) SetWriteDeadline(deadline time.Time) error {
+	return f.ResponseWriter.(writeDeadlineSetter).SetWriteDeadline(deadline)
+}
+
+func (f bufferedFullDuplexFeature) EnableFullDuplex() error {
+	return f.ResponseWriter.(fullDuplexEnabler).EnableFullDuplex()
+}
+
+// bufferedFeaturePicker is the counterpart of featurePicker for
+// BufferedResponseWriters. It is filled in by initBufferedFeaturePicker,
+// generated by featuregen.go.
+var bufferedFeaturePicker = make([]func(writer *BufferedResponseWriter) ResponseWriter, allFeatures+1)
+
+// wrapBufferedFeature wraps b into a ResponseWriter implementing the optional
+// interfaces of the underlying ResponseWriter.
+func wrapBufferedFeature(b *BufferedResponseWriter) ResponseWriter {
+	return bufferedFeaturePicker[detectFeatures(b.ResponseWriter)](b)
+}
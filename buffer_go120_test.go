@@ -0,0 +1,46 @@
+//go:build go1.20
+// +build go1.20
+
+package negroni
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBufferResponseController(t *testing.T) {
+	full := &fullResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+	n := New(NewBuffer())
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("Hello"))
+
+		rc := http.NewResponseController(rw)
+		expect(t, rc.SetWriteDeadline(time.Now().Add(time.Minute)), nil)
+		brw, _ := GetBufferedResponseWriter(rw)
+		expect(t, brw.Buffered(), true)
+
+		expect(t, rc.Flush(), nil)
+		expect(t, brw.Buffered(), false)
+	})
+
+	n.ServeHTTP(full, (*http.Request)(nil))
+	expect(t, full.Body.String(), "Hello")
+	expect(t, strings.Join(full.calls, ","), "SetWriteDeadline,FlushError")
+}
+
+func TestBufferResponseControllerNotSupported(t *testing.T) {
+	rec := httptest.NewRecorder()
+	n := New(NewBuffer())
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("Hello"))
+		err := http.NewResponseController(rw).SetReadDeadline(time.Time{})
+		expect(t, errors.Is(err, http.ErrNotSupported), true)
+	})
+
+	n.ServeHTTP(rec, (*http.Request)(nil))
+	expect(t, rec.Body.String(), "Hello")
+}
@@ -0,0 +1,246 @@
+package negroni
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBufferReplacesErrorPage(t *testing.T) {
+	n := New(NewBuffer())
+	n.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		next(rw, r)
+
+		brw, ok := GetBufferedResponseWriter(rw)
+		expect(t, ok, true)
+		expect(t, brw.Buffered(), true)
+		expect(t, brw.Status(), http.StatusInternalServerError)
+		expect(t, string(brw.Body()), "stack trace")
+		if brw.Status() >= 500 {
+			brw.Header().Set("Content-Type", "text/html")
+			brw.SetStatus(http.StatusServiceUnavailable)
+			brw.SetBody([]byte("<h1>Come back later</h1>"))
+		}
+	})
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "text/plain")
+		rw.Header().Set("Content-Length", "11")
+		rw.WriteHeader(http.StatusInternalServerError)
+		rw.Write([]byte("stack trace"))
+	})
+
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, (*http.Request)(nil))
+
+	expect(t, rec.Code, http.StatusServiceUnavailable)
+	expect(t, rec.Header().Get("Content-Type"), "text/html")
+	expect(t, rec.Header().Get("Content-Length"), "")
+	expect(t, rec.Body.String(), "<h1>Come back later</h1>")
+}
+
+func TestBufferedResponseWriterStreamsPastLimit(t *testing.T) {
+	rec := httptest.NewRecorder()
+	brw := NewBufferedResponseWriter(rec, 8)
+
+	brw.Header().Set("X-Buffered", "yes")
+	brw.Write([]byte("Hello"))
+	expect(t, brw.Buffered(), true)
+	expect(t, brw.Size(), 5)
+	expect(t, rec.Body.Len(), 0)
+	expect(t, rec.Header().Get("X-Buffered"), "")
+
+	brw.Write([]byte(" world"))
+	expect(t, brw.Buffered(), false)
+	expect(t, rec.Body.String(), "Hello world")
+	expect(t, rec.Header().Get("X-Buffered"), "yes")
+	expect(t, brw.Status(), http.StatusOK)
+	expect(t, brw.Size(), 11)
+
+	brw.SetBody([]byte("ignored"))
+	expect(t, brw.Commit(), nil)
+	expect(t, rec.Body.String(), "Hello world")
+}
+
+func TestBufferedResponseWriterFlushCommits(t *testing.T) {
+	rec := httptest.NewRecorder()
+	brw := NewBufferedResponseWriter(rec, DefaultBufferLimit)
+
+	brw.Write([]byte("event"))
+	wrapBufferedFeature(brw).(http.Flusher).Flush()
+	expect(t, brw.Buffered(), false)
+	expect(t, rec.Flushed, true)
+	expect(t, rec.Body.String(), "event")
+}
+
+func TestBufferedResponseWriterReset(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("X-Outer", "1")
+	brw := NewBufferedResponseWriter(rec, DefaultBufferLimit)
+
+	brw.WriteHeader(http.StatusNotFound)
+	brw.Write([]byte("not found"))
+	brw.Header().Del("X-Outer")
+	brw.Reset()
+	expect(t, brw.Written(), false)
+
+	brw.Write([]byte("found"))
+	brw.Commit()
+	expect(t, rec.Code, http.StatusOK)
+	expect(t, rec.Body.String(), "found")
+	expect(t, rec.Header().Get("X-Outer"), "")
+}
+
+func TestBufferedResponseWriterBefore(t *testing.T) {
+	called := false
+	rec := httptest.NewRecorder()
+	brw := NewBufferedResponseWriter(rec, DefaultBufferLimit)
+	brw.Before(func(ResponseWriter) {
+		called = true
+	})
+
+	brw.WriteHeader(http.StatusAccepted)
+	expect(t, called, false)
+	brw.Commit()
+	expect(t, called, true)
+	expect(t, rec.Code, http.StatusAccepted)
+}
+
+func TestBufferNestedNegroni(t *testing.T) {
+	inner := New()
+	inner.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("inner"))
+	})
+
+	n := New(NewBuffer())
+	n.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		next(rw, r)
+		brw, _ := GetBufferedResponseWriter(rw)
+		brw.SetBody(append(brw.Body(), " signed"...))
+	})
+	n.UseHandler(inner)
+
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, (*http.Request)(nil))
+	expect(t, rec.Body.String(), "inner signed")
+}
+
+func TestBufferedResponseWriterHijack(t *testing.T) {
+	hijackable := newHijackableResponse()
+	n := New(NewBuffer())
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusSwitchingProtocols)
+		rw.(http.Hijacker).Hijack()
+
+		brw, _ := GetBufferedResponseWriter(rw)
+		expect(t, brw.Buffered(), false)
+		_, err := rw.Write([]byte("too late"))
+		expect(t, err, http.ErrHijacked)
+	})
+
+	n.ServeHTTP(hijackable, (*http.Request)(nil))
+	expect(t, hijackable.Hijacked, true)
+}
+
+func TestBufferedResponseWriterFeatures(t *testing.T) {
+	n := New(NewBuffer())
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, ok := rw.(http.Flusher)
+		expect(t, ok, true)
+		_, ok = rw.(http.Hijacker)
+		expect(t, ok, false)
+		_, ok = rw.(http.Pusher)
+		expect(t, ok, false)
+
+		io.WriteString(rw, "Hello")
+		brw, _ := GetBufferedResponseWriter(rw)
+		expect(t, brw.Buffered(), true)
+	})
+
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, (*http.Request)(nil))
+	expect(t, rec.Body.String(), "Hello")
+}
+
+func TestBufferedResponseWriterFeatureCombinations(t *testing.T) {
+	expect(t, len(bufferedFeaturePicker), allFeatures+1)
+	for combination := 0; combination <= allFeatures; combination++ {
+		full := &fullResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+		underlying := featurePicker[combination](&responseWriter{ResponseWriter: full})
+
+		rw := wrapBufferedFeature(NewBufferedResponseWriter(underlying, DefaultBufferLimit))
+		for _, check := range featureChecks {
+			if check.has(rw) != (combination&check.feature != 0) {
+				t.Fatalf("combination %#x: wrong %s support", combination, check.method)
+			}
+			if combination&check.feature == 0 {
+				continue
+			}
+
+			// Commit the response first, so that writes are passed
+			// through rather than buffered.
+			full.calls = nil
+			brw := NewBufferedResponseWriter(featurePicker[combination](&responseWriter{ResponseWriter: full}), DefaultBufferLimit)
+			brw.Commit()
+			check.call(wrapBufferedFeature(brw))
+			if len(full.calls) != 1 || full.calls[0] != check.method {
+				t.Fatalf("combination %#x: %s called %v", combination, check.method, full.calls)
+			}
+		}
+	}
+}
+
+func TestBufferedResponseWriterUnwrapCommits(t *testing.T) {
+	rec := httptest.NewRecorder()
+	brw := NewBufferedResponseWriter(rec, DefaultBufferLimit)
+
+	brw.Write([]byte("Hello"))
+	brw.Unwrap()
+	expect(t, brw.Buffered(), false)
+	expect(t, rec.Body.String(), "Hello")
+}
+
+func TestBufferLogger(t *testing.T) {
+	entries := &entryRecorder{}
+	l := NewLogger()
+	l.SetStructuredLogger(entries)
+	l.SetBodyCapture(100)
+	l.SetTrailers("grpc-status")
+
+	n := New(NewBuffer(), l)
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		DeclareTrailer(rw, "Grpc-Status")
+		rw.Write([]byte("Hello"))
+		rw.Header().Set("Grpc-Status", "0")
+	})
+
+	req, _ := http.NewRequest("GET", "http://localhost/", nil)
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, req)
+
+	expect(t, len(entries.entries), 1)
+	entry := entries.entries[0]
+	expect(t, entry.Status, http.StatusOK)
+	expect(t, entry.Size, 5)
+	expect(t, entry.ResponseBody, "Hello")
+	expect(t, entry.Writes, 1)
+	refute(t, entry.TimeToFirstByte, time.Duration(0))
+	expect(t, entry.Trailers.Get("Grpc-Status"), "0")
+	expect(t, entry.Hijacked, false)
+	expect(t, rec.Body.String(), "Hello")
+}
+
+func TestBufferedResponseWriterInterfaces(t *testing.T) {
+	rw := wrapBufferedFeature(NewBufferedResponseWriter(httptest.NewRecorder(), DefaultBufferLimit))
+	_, ok := rw.(MetricsResponseWriter)
+	expect(t, ok, true)
+	_, ok = rw.(TeeResponseWriter)
+	expect(t, ok, true)
+	_, ok = rw.(TrailerResponseWriter)
+	expect(t, ok, true)
+	_, ok = rw.(HijackedResponseWriter)
+	expect(t, ok, true)
+	_, ok = rw.(InformationalResponseWriter)
+	expect(t, ok, true)
+}
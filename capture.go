@@ -0,0 +1,98 @@
+package negroni
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// BodyCapture is an io.Writer keeping a copy of the first bytes written to
+// it, up to a limit. Writes never fail, so it can be used as a sink for
+// ResponseWriter.Tee without disturbing the response.
+type BodyCapture struct {
+	limit     int
+	buf       bytes.Buffer
+	truncated bool
+}
+
+// NewBodyCapture returns a BodyCapture keeping at most limit bytes.
+func NewBodyCapture(limit int) *BodyCapture {
+	return &BodyCapture{limit: limit}
+}
+
+// Write copies as much of p as fits within the limit. It always reports
+// len(p) bytes written.
+func (c *BodyCapture) Write(p []byte) (int, error) {
+	if room := c.limit - c.buf.Len(); len(p) > room {
+		if room > 0 {
+			c.buf.Write(p[:room])
+		}
+		c.truncated = true
+		return len(p), nil
+	}
+	return c.buf.Write(p)
+}
+
+// Bytes returns the captured bytes.
+func (c *BodyCapture) Bytes() []byte {
+	return c.buf.Bytes()
+}
+
+// String returns the captured bytes as a string.
+func (c *BodyCapture) String() string {
+	return c.buf.String()
+}
+
+// Truncated returns whether more bytes than the limit were written.
+func (c *BodyCapture) Truncated() bool {
+	return c.truncated
+}
+
+// TeeResponseWriter is a ResponseWriter that can mirror the response body to
+// other writers, such as the writers created by NewResponseWriter.
+type TeeResponseWriter interface {
+	ResponseWriter
+	// Tee mirrors every body byte written from now on to w, including the
+	// bytes sent through ReadFrom. Errors returned by w are ignored.
+	Tee(w io.Writer)
+}
+
+func (rw *responseWriter) Tee(w io.Writer) {
+	if tee, ok := rw.shared.(TeeResponseWriter); ok {
+		tee.Tee(w)
+		return
+	}
+	w = teeWriter{w}
+	if tee := rw.teeWriter(); tee != nil {
+		w = io.MultiWriter(tee, w)
+	}
+	rw.extra().tee = w
+}
+
+// teeWriter ignores the errors of the writer a response is mirrored to, so
+// they neither reach the handler writing the response nor keep the other
+// writers from receiving the body.
+type teeWriter struct {
+	io.Writer
+}
+
+func (w teeWriter) Write(p []byte) (int, error) {
+	w.Writer.Write(p)
+	return len(p), nil
+}
+
+// CaptureRequestBody replaces the body of r with one that mirrors the first
+// limit bytes read from it into the returned BodyCapture. Only the bytes the
+// handlers actually read are captured.
+func CaptureRequestBody(r *http.Request, limit int) *BodyCapture {
+	c := NewBodyCapture(limit)
+	if r.Body != nil && r.Body != http.NoBody {
+		r.Body = &teeReadCloser{Reader: io.TeeReader(r.Body, c), Closer: r.Body}
+	}
+	return c
+}
+
+type teeReadCloser struct {
+	io.Reader
+	io.Closer
+}
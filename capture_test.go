@@ -0,0 +1,128 @@
+package negroni
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBodyCapture(t *testing.T) {
+	c := NewBodyCapture(8)
+
+	n, err := c.Write([]byte("Hello"))
+	expect(t, n, 5)
+	expect(t, err, nil)
+	expect(t, c.Truncated(), false)
+
+	n, err = c.Write([]byte(" world"))
+	expect(t, n, 6)
+	expect(t, err, nil)
+	expect(t, c.String(), "Hello wo")
+	expect(t, c.Truncated(), true)
+
+	c.Write([]byte("!"))
+	expect(t, string(c.Bytes()), "Hello wo")
+}
+
+func TestResponseWriterTee(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rw := NewResponseWriter(rec)
+	first := NewBodyCapture(100)
+	second := NewBodyCapture(100)
+
+	rw.Write([]byte("before "))
+	rw.(TeeResponseWriter).Tee(first)
+	rw.Write([]byte("Hello"))
+	rw.(TeeResponseWriter).Tee(second)
+	io.WriteString(rw, " world")
+	io.Copy(rw, &mockReader{readStr: "!"})
+
+	expect(t, rec.Body.String(), "before Hello world!")
+	expect(t, first.String(), "Hello world!")
+	expect(t, second.String(), " world!")
+}
+
+func TestResponseWriterTeeReadFrom(t *testing.T) {
+	mrw := &mockResponseWriterWithReadFrom{ResponseRecorder: httptest.NewRecorder()}
+	rw := NewResponseWriter(mrw)
+	c := NewBodyCapture(100)
+	rw.(TeeResponseWriter).Tee(c)
+
+	io.Copy(rw, &mockReader{readStr: "Hello world"})
+	expect(t, mrw.writtenStr, "Hello world")
+	expect(t, c.String(), "Hello world")
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("sink failed")
+}
+
+func TestResponseWriterTeeIgnoresErrors(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rw := NewResponseWriter(rec)
+	c := NewBodyCapture(100)
+	rw.(TeeResponseWriter).Tee(failingWriter{})
+	rw.(TeeResponseWriter).Tee(c)
+
+	n, err := rw.Write([]byte("Hello"))
+	expect(t, n, 5)
+	expect(t, err, nil)
+	n, err = io.WriteString(rw, " world")
+	expect(t, n, 6)
+	expect(t, err, nil)
+
+	// Call ReadFrom directly, as io.Copy from a strings.Reader uses its
+	// WriteTo method instead.
+	n64, err := rw.(io.ReaderFrom).ReadFrom(&mockReader{readStr: "!"})
+	expect(t, n64, int64(1))
+	expect(t, err, nil)
+
+	expect(t, rec.Body.String(), "Hello world!")
+	expect(t, c.String(), "Hello world!")
+	expect(t, rw.Size(), 12)
+}
+
+func TestCaptureRequestBody(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://localhost/", strings.NewReader("name=negroni"))
+	c := CaptureRequestBody(req, 4)
+
+	body, err := io.ReadAll(req.Body)
+	expect(t, err, nil)
+	expect(t, string(body), "name=negroni")
+	expect(t, c.String(), "name")
+	expect(t, c.Truncated(), true)
+	expect(t, req.Body.Close(), nil)
+
+	req, _ = http.NewRequest("GET", "http://localhost/", nil)
+	c = CaptureRequestBody(req, 4)
+	expect(t, req.Body, nil)
+	expect(t, c.String(), "")
+}
+
+func Test_LoggerBodyCapture(t *testing.T) {
+	var buff bytes.Buffer
+	l := NewLogger()
+	l.ALogger = log.New(&buff, "", 0)
+	l.SetFormat("{{.RequestBody}} -> {{.ResponseBody}}")
+	l.SetBodyCapture(5)
+
+	n := New(l)
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rw.Write(bytes.ToUpper(body))
+	})
+
+	req, _ := http.NewRequest("POST", "http://localhost/", strings.NewReader("negroni"))
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, req)
+
+	expect(t, rec.Body.String(), "NEGRONI")
+	expect(t, strings.TrimSpace(buff.String()), "negro -> NEGRO")
+}
@@ -0,0 +1,136 @@
+package negroni
+
+import (
+	"net"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Predicate reports whether a request matches some condition. Predicates are
+// used with If and Unless to apply a Handler to a subset of requests.
+type Predicate func(r *http.Request) bool
+
+// If returns a Handler that invokes handler only for requests matching pred.
+// Other requests are passed straight along to the next middleware in the chain.
+//
+//	n.Use(negroni.If(negroni.All(negroni.Methods("GET"), negroni.PathPrefix("/assets")), static))
+func If(pred Predicate, handler Handler) Handler {
+	return HandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		if pred(r) {
+			handler.ServeHTTP(rw, r, next)
+			return
+		}
+		next(rw, r)
+	})
+}
+
+// Unless returns a Handler that invokes handler only for requests that do not
+// match pred. Matching requests are passed straight along to the next
+// middleware in the chain.
+//
+//	n.Use(negroni.Unless(negroni.PathPrefix("/healthz"), negroni.NewLogger()))
+func Unless(pred Predicate, handler Handler) Handler {
+	return If(func(r *http.Request) bool { return !pred(r) }, handler)
+}
+
+// All returns a Predicate matching requests that match every one of preds.
+func All(preds ...Predicate) Predicate {
+	return func(r *http.Request) bool {
+		for _, pred := range preds {
+			if !pred(r) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Any returns a Predicate matching requests that match at least one of preds.
+func Any(preds ...Predicate) Predicate {
+	return func(r *http.Request) bool {
+		for _, pred := range preds {
+			if pred(r) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PathPrefix returns a Predicate matching requests whose URL path starts with
+// any of the given prefixes.
+func PathPrefix(prefixes ...string) Predicate {
+	return func(r *http.Request) bool {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PathGlob returns a Predicate matching requests whose URL path matches any of
+// the given shell patterns, using the syntax of path.Match. Note that "*" does
+// not match across "/" separators. Malformed patterns never match.
+func PathGlob(patterns ...string) Predicate {
+	return func(r *http.Request) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, r.URL.Path); ok {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PathRegexp returns a Predicate matching requests whose URL path matches the
+// regular expression expr. It panics if expr cannot be parsed.
+func PathRegexp(expr string) Predicate {
+	re := regexp.MustCompile(expr)
+	return func(r *http.Request) bool {
+		return re.MatchString(r.URL.Path)
+	}
+}
+
+// Methods returns a Predicate matching requests using any of the given HTTP
+// methods.
+func Methods(methods ...string) Predicate {
+	return func(r *http.Request) bool {
+		for _, method := range methods {
+			if r.Method == method {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Host returns a Predicate matching requests for any of the given hosts. Hosts
+// are compared case-insensitively, and the port of the request's Host is
+// ignored unless the given host includes one.
+func Host(hosts ...string) Predicate {
+	return func(r *http.Request) bool {
+		hostname := r.Host
+		if h, _, err := net.SplitHostPort(r.Host); err == nil {
+			hostname = h
+		}
+		for _, host := range hosts {
+			if strings.EqualFold(host, r.Host) || strings.EqualFold(host, hostname) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// HeaderPresent returns a Predicate matching requests that carry the named
+// header, whatever its value.
+func HeaderPresent(name string) Predicate {
+	return func(r *http.Request) bool {
+		_, ok := r.Header[http.CanonicalHeaderKey(name)]
+		return ok
+	}
+}
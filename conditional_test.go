@@ -0,0 +1,72 @@
+package negroni
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIfUnless(t *testing.T) {
+	result := ""
+	mark := func(s string) Handler {
+		return HandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+			result += s
+			next(rw, r)
+		})
+	}
+
+	n := New(
+		If(PathPrefix("/assets"), mark("if")),
+		Unless(PathPrefix("/healthz"), mark("unless")),
+		mark("end"),
+	)
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/assets/app.js", "ifunlessend"},
+		{"/healthz", "end"},
+		{"/", "unlessend"},
+	}
+	for _, tt := range tests {
+		result = ""
+		req, _ := http.NewRequest("GET", "http://localhost"+tt.path, nil)
+		n.ServeHTTP(httptest.NewRecorder(), req)
+		expect(t, result, tt.expected)
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://Example.com:8080/assets/css/app.css", nil)
+	req.Header.Set("X-Debug", "")
+
+	tests := []struct {
+		name     string
+		pred     Predicate
+		expected bool
+	}{
+		{"PathPrefix", PathPrefix("/static", "/assets"), true},
+		{"PathPrefix mismatch", PathPrefix("/static"), false},
+		{"PathGlob", PathGlob("/assets/*/*.css"), true},
+		{"PathGlob does not cross separators", PathGlob("/assets/*.css"), false},
+		{"PathRegexp", PathRegexp(`\.css$`), true},
+		{"PathRegexp mismatch", PathRegexp(`\.js$`), false},
+		{"Methods", Methods("HEAD", "GET"), true},
+		{"Methods mismatch", Methods("POST"), false},
+		{"Host", Host("example.com"), true},
+		{"Host with port", Host("example.com:8080"), true},
+		{"Host mismatch", Host("example.org"), false},
+		{"HeaderPresent", HeaderPresent("x-debug"), true},
+		{"HeaderPresent mismatch", HeaderPresent("Authorization"), false},
+		{"All", All(Methods("GET"), PathPrefix("/assets")), true},
+		{"All mismatch", All(Methods("GET"), PathPrefix("/static")), false},
+		{"Any", Any(Methods("POST"), PathPrefix("/assets")), true},
+		{"Any mismatch", Any(Methods("POST"), PathPrefix("/static")), false},
+	}
+	for _, tt := range tests {
+		if tt.pred(req) != tt.expected {
+			t.Errorf("%s: expected %v", tt.name, tt.expected)
+		}
+	}
+}
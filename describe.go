@@ -0,0 +1,112 @@
+package negroni
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+)
+
+// NamedHandler is a Handler that reports its own name, such as the handlers
+// returned by Named. The name is used by ByName and Describe.
+type NamedHandler interface {
+	Handler
+	Name() string
+}
+
+// HandlerInfo describes a handler in a Negroni middleware stack.
+type HandlerInfo struct {
+	// Index is the position of the handler in the stack.
+	Index int `json:"index"`
+	// Name is the name of a NamedHandler, the function name of a
+	// HandlerFunc, or the type of the handler otherwise.
+	Name string `json:"name"`
+	// Type is the Go type of the handler, looking through Named.
+	Type string `json:"type"`
+	// Source is the file:line where the handler's ServeHTTP method or
+	// function is defined, if known.
+	Source string `json:"source,omitempty"`
+}
+
+// Describe returns a description of each handler in the middleware stack, in
+// the order they are invoked.
+func (n *Negroni) Describe() []HandlerInfo {
+	handlers := n.Handlers()
+	infos := make([]HandlerInfo, len(handlers))
+	for i, h := range handlers {
+		infos[i] = describeHandler(i, h)
+	}
+	return infos
+}
+
+// DescribeHandler returns an http.Handler rendering Describe, meant to be
+// served on an admin or debug port. The description is rendered as JSON if the
+// request has a "format=json" query parameter or accepts application/json, and
+// as aligned plain text otherwise.
+func (n *Negroni) DescribeHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		infos := n.Describe()
+		if r.URL.Query().Get("format") == "json" || strings.Contains(r.Header.Get("Accept"), "application/json") {
+			rw.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(rw).Encode(infos)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		tw := tabwriter.NewWriter(rw, 0, 4, 2, ' ', 0)
+		for _, info := range infos {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", info.Index, info.Name, info.Type, info.Source)
+		}
+		tw.Flush()
+	})
+}
+
+func describeHandler(i int, h Handler) HandlerInfo {
+	inner := unwrapHandler(h)
+	info := HandlerInfo{
+		Index: i,
+		Type:  fmt.Sprintf("%T", inner),
+	}
+
+	var fn *runtime.Func
+	f, isFunc := inner.(HandlerFunc)
+	if isFunc {
+		fn = runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	} else {
+		fn = serveHTTPFunc(reflect.TypeOf(inner))
+	}
+	if fn != nil {
+		file, line := fn.FileLine(fn.Entry())
+		info.Source = fmt.Sprintf("%s:%d", file, line)
+	}
+
+	if named, ok := h.(NamedHandler); ok {
+		info.Name = named.Name()
+	} else if isFunc && fn != nil {
+		info.Name = fn.Name()
+	} else {
+		info.Name = info.Type
+	}
+	return info
+}
+
+// serveHTTPFunc returns the ServeHTTP method of typ, preferring the method
+// declared on the element type of a pointer over the compiler generated
+// wrapper for the pointer type.
+func serveHTTPFunc(typ reflect.Type) *runtime.Func {
+	if typ == nil {
+		return nil
+	}
+	if typ.Kind() == reflect.Ptr {
+		if m, ok := typ.Elem().MethodByName("ServeHTTP"); ok {
+			return runtime.FuncForPC(m.Func.Pointer())
+		}
+	}
+	if m, ok := typ.MethodByName("ServeHTTP"); ok {
+		return runtime.FuncForPC(m.Func.Pointer())
+	}
+	return nil
+}
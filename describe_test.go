@@ -0,0 +1,58 @@
+package negroni
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func describeTestMiddleware(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	next(rw, r)
+}
+
+func TestNegroniDescribe(t *testing.T) {
+	n := New(
+		NewRecovery(),
+		Named("access-log", NewLogger()),
+		HandlerFunc(describeTestMiddleware),
+	)
+
+	infos := n.Describe()
+	expect(t, len(infos), 3)
+
+	expect(t, infos[0].Index, 0)
+	expect(t, infos[0].Name, "*negroni.Recovery")
+	expect(t, infos[0].Type, "*negroni.Recovery")
+	expect(t, strings.Contains(infos[0].Source, "recovery.go:"), true)
+
+	expect(t, infos[1].Name, "access-log")
+	expect(t, infos[1].Type, "*negroni.Logger")
+	expect(t, strings.Contains(infos[1].Source, "logger.go:"), true)
+
+	expect(t, infos[2].Name, "github.com/urfave/negroni/v3.describeTestMiddleware")
+	expect(t, infos[2].Type, "negroni.HandlerFunc")
+	expect(t, strings.Contains(infos[2].Source, "describe_test.go:"), true)
+}
+
+func TestNegroniDescribeHandler(t *testing.T) {
+	n := New(Named("access-log", NewLogger()))
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "http://localhost/debug/negroni?format=json", nil)
+	n.DescribeHandler().ServeHTTP(rec, req)
+
+	var infos []HandlerInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &infos); err != nil {
+		t.Fatal(err)
+	}
+	expect(t, rec.Header().Get("Content-Type"), "application/json")
+	expect(t, len(infos), 1)
+	expect(t, infos[0].Name, "access-log")
+
+	rec = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "http://localhost/debug/negroni", nil)
+	n.DescribeHandler().ServeHTTP(rec, req)
+	expect(t, strings.HasPrefix(rec.Body.String(), "0  access-log  *negroni.Logger"), true)
+}
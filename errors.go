@@ -0,0 +1,198 @@
+package negroni
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"os"
+)
+
+const (
+	errorHTML = `<html>
+<head><title>{{.Status}} {{.StatusText}}</title></head>
+<body>
+<h1>{{.Status}} {{.StatusText}}</h1>
+<p>{{.Message}}</p>
+</body>
+</html>`
+)
+
+var errorHTMLTemplate = template.Must(template.New("ErrorPage").Parse(errorHTML))
+
+type errorsKey struct{}
+
+// defaultErrors handles the errors of ErrorHandlerFuncs used without an Errors
+// middleware. As nothing was configured, error messages are not sent to the
+// client, since they may hold internal details.
+var defaultErrors = &Errors{
+	Logger:    log.New(os.Stdout, "[negroni] ", 0),
+	Formatter: &TextErrorFormatter{},
+}
+
+// ErrorHandlerFunc is an adapter to allow the use of functions returning an
+// error as Negroni handlers. If the function returns a non-nil error, it is
+// rendered and logged by the nearest Errors middleware earlier in the stack.
+// If there is none, the error is logged to stdout and only its status text is
+// written to the client, as a plain text response. A function returning an
+// error should not have called next.
+type ErrorHandlerFunc func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) error
+
+func (h ErrorHandlerFunc) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	if err := h(rw, r, next); err != nil {
+		e, ok := r.Context().Value(errorsKey{}).(*Errors)
+		if !ok {
+			e = defaultErrors
+		}
+		e.handleError(rw, r, err)
+	}
+}
+
+// StatusCoder is implemented by errors that map to a specific HTTP status
+// code. Errors that do not implement it are rendered as 500 Internal Server
+// Error.
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// ErrorInformation contains all elements for rendering an error returned by
+// an ErrorHandlerFunc.
+type ErrorInformation struct {
+	// Err is the error returned by the handler.
+	Err error
+	// Status is the HTTP status code of the response.
+	Status int
+	// Message is the text shown to the client: the error's message if
+	// Errors.PrintError is set, or the status text otherwise.
+	Message string
+	Request *http.Request
+}
+
+// StatusText returns the text for the status code of the response.
+func (e *ErrorInformation) StatusText() string {
+	return http.StatusText(e.Status)
+}
+
+// ErrorFormatter is an interface on object can implement to render errors
+// returned by an ErrorHandlerFunc. FormatError must write the complete
+// response, including the status code given in infos.Status.
+type ErrorFormatter interface {
+	FormatError(rw http.ResponseWriter, r *http.Request, infos *ErrorInformation)
+}
+
+// TextErrorFormatter renders errors as plain text.
+type TextErrorFormatter struct{}
+
+func (t *TextErrorFormatter) FormatError(rw http.ResponseWriter, r *http.Request, infos *ErrorInformation) {
+	rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	rw.Header().Set("X-Content-Type-Options", "nosniff")
+	rw.WriteHeader(infos.Status)
+	fmt.Fprintln(rw, infos.Message)
+}
+
+// JSONErrorFormatter renders errors as a JSON object with "status" and
+// "error" members.
+type JSONErrorFormatter struct{}
+
+func (j *JSONErrorFormatter) FormatError(rw http.ResponseWriter, r *http.Request, infos *ErrorInformation) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(infos.Status)
+	json.NewEncoder(rw).Encode(struct {
+		Status int    `json:"status"`
+		Error  string `json:"error"`
+	}{infos.Status, infos.Message})
+}
+
+// ProblemJSONErrorFormatter renders errors as RFC 7807 problem details.
+type ProblemJSONErrorFormatter struct{}
+
+func (p *ProblemJSONErrorFormatter) FormatError(rw http.ResponseWriter, r *http.Request, infos *ErrorInformation) {
+	problem := struct {
+		Type     string `json:"type"`
+		Title    string `json:"title"`
+		Status   int    `json:"status"`
+		Detail   string `json:"detail,omitempty"`
+		Instance string `json:"instance,omitempty"`
+	}{
+		Type:   "about:blank",
+		Title:  infos.StatusText(),
+		Status: infos.Status,
+	}
+	if infos.Message != problem.Title {
+		problem.Detail = infos.Message
+	}
+	if r != nil && r.URL != nil {
+		problem.Instance = r.URL.Path
+	}
+
+	rw.Header().Set("Content-Type", "application/problem+json")
+	rw.WriteHeader(infos.Status)
+	json.NewEncoder(rw).Encode(problem)
+}
+
+// HTMLErrorFormatter renders errors as a minimal HTML page.
+type HTMLErrorFormatter struct{}
+
+func (h *HTMLErrorFormatter) FormatError(rw http.ResponseWriter, r *http.Request, infos *ErrorInformation) {
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+	rw.WriteHeader(infos.Status)
+	errorHTMLTemplate.Execute(rw, infos)
+}
+
+// Errors is a Negroni middleware that renders and logs the errors returned by
+// the ErrorHandlerFuncs after it in the stack.
+type Errors struct {
+	Logger    ALogger
+	Formatter ErrorFormatter
+	// PrintError includes the error's message in responses. If false, only
+	// the status text is shown to the client.
+	PrintError bool
+}
+
+// NewErrors returns a new instance of Errors
+func NewErrors() *Errors {
+	return &Errors{
+		Logger:     log.New(os.Stdout, "[negroni] ", 0),
+		Formatter:  &TextErrorFormatter{},
+		PrintError: true,
+	}
+}
+
+func (e *Errors) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	next(rw, r.WithContext(context.WithValue(r.Context(), errorsKey{}, e)))
+}
+
+func (e *Errors) handleError(rw http.ResponseWriter, r *http.Request, err error) {
+	infos := &ErrorInformation{
+		Err:     err,
+		Status:  http.StatusInternalServerError,
+		Request: r,
+	}
+	var coder StatusCoder
+	if errors.As(err, &coder) {
+		infos.Status = coder.StatusCode()
+	}
+	if e.PrintError {
+		infos.Message = err.Error()
+	} else {
+		infos.Message = infos.StatusText()
+	}
+
+	if e.Logger != nil {
+		e.Logger.Printf("%s %s: %d %s", r.Method, r.URL.Path, infos.Status, err)
+	}
+
+	// The handler may have started the response before failing, in which
+	// case it can only be logged.
+	if res, ok := rw.(ResponseWriter); ok && res.Written() {
+		return
+	}
+	if e.Formatter != nil {
+		e.Formatter.FormatError(rw, r, infos)
+	} else {
+		http.Error(rw, infos.Message, infos.Status)
+	}
+}
@@ -0,0 +1,138 @@
+package negroni
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type statusError struct {
+	status int
+	msg    string
+}
+
+func (e *statusError) Error() string   { return e.msg }
+func (e *statusError) StatusCode() int { return e.status }
+
+func TestErrors(t *testing.T) {
+	var buff bytes.Buffer
+	e := NewErrors()
+	e.Logger = log.New(&buff, "[negroni] ", 0)
+
+	n := New(e)
+	n.Use(ErrorHandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) error {
+		return fmt.Errorf("loading user: %w", &statusError{http.StatusNotFound, "no such user"})
+	}))
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "http://localhost/users/1", nil)
+	n.ServeHTTP(rec, req)
+
+	expect(t, rec.Code, http.StatusNotFound)
+	expect(t, rec.Header().Get("Content-Type"), "text/plain; charset=utf-8")
+	expect(t, rec.Body.String(), "loading user: no such user\n")
+	expect(t, strings.TrimSpace(buff.String()), "[negroni] GET /users/1: 404 loading user: no such user")
+}
+
+func TestErrors_noError(t *testing.T) {
+	n := New(NewErrors())
+	n.Use(ErrorHandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) error {
+		next(rw, r)
+		return nil
+	}))
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusCreated)
+	})
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "http://localhost/users", nil)
+	n.ServeHTTP(rec, req)
+	expect(t, rec.Code, http.StatusCreated)
+}
+
+func TestErrors_alreadyWritten(t *testing.T) {
+	var buff bytes.Buffer
+	e := NewErrors()
+	e.Logger = log.New(&buff, "", 0)
+
+	n := New(e)
+	n.Use(ErrorHandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) error {
+		rw.Write([]byte("partial"))
+		return errors.New("stream broke")
+	}))
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "http://localhost/stream", nil)
+	n.ServeHTTP(rec, req)
+
+	expect(t, rec.Code, http.StatusOK)
+	expect(t, rec.Body.String(), "partial")
+	refute(t, buff.Len(), 0)
+}
+
+func TestErrorHandlerFunc_withoutErrors(t *testing.T) {
+	var buff bytes.Buffer
+	defer func(logger ALogger) { defaultErrors.Logger = logger }(defaultErrors.Logger)
+	defaultErrors.Logger = log.New(&buff, "[negroni] ", 0)
+
+	n := New(ErrorHandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) error {
+		return errors.New("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "http://localhost/", nil)
+	n.ServeHTTP(rec, req)
+
+	expect(t, rec.Code, http.StatusInternalServerError)
+	expect(t, rec.Body.String(), "Internal Server Error\n")
+	expect(t, strings.TrimSpace(buff.String()), "[negroni] GET /: 500 boom")
+}
+
+func TestErrorFormatters(t *testing.T) {
+	tests := []struct {
+		formatter   ErrorFormatter
+		printError  bool
+		contentType string
+		body        string
+	}{
+		{&TextErrorFormatter{}, false, "text/plain; charset=utf-8", "Internal Server Error\n"},
+		{&JSONErrorFormatter{}, true, "application/json", `{"status":500,"error":"boom"}` + "\n"},
+		{&ProblemJSONErrorFormatter{}, true, "application/problem+json", `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"boom","instance":"/"}` + "\n"},
+		{&ProblemJSONErrorFormatter{}, false, "application/problem+json", `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/"}` + "\n"},
+	}
+	for _, tt := range tests {
+		e := &Errors{Formatter: tt.formatter, PrintError: tt.printError}
+		n := New(e, ErrorHandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) error {
+			return errors.New("boom")
+		}))
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "http://localhost/", nil)
+		n.ServeHTTP(rec, req)
+
+		expect(t, rec.Code, http.StatusInternalServerError)
+		expect(t, rec.Header().Get("Content-Type"), tt.contentType)
+		expect(t, rec.Body.String(), tt.body)
+	}
+}
+
+func TestHTMLErrorFormatter(t *testing.T) {
+	e := &Errors{Formatter: &HTMLErrorFormatter{}, PrintError: true}
+	n := New(e, ErrorHandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) error {
+		return &statusError{http.StatusBadRequest, "<script>"}
+	}))
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "http://localhost/", nil)
+	n.ServeHTTP(rec, req)
+
+	expect(t, rec.Code, http.StatusBadRequest)
+	expect(t, rec.Header().Get("Content-Type"), "text/html; charset=utf-8")
+	expect(t, strings.Contains(rec.Body.String(), "<h1>400 Bad Request</h1>"), true)
+	expect(t, strings.Contains(rec.Body.String(), "&lt;script&gt;"), true)
+}
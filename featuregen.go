@@ -0,0 +1,107 @@
+//go:build ignore
+// +build ignore
+
+// featuregen generates response_writer_feature_generated.go and
+// buffer_feature_generated.go, which wrap a responseWriter, respectively a
+// BufferedResponseWriter, into an anonymous struct for every combination of
+// the optional interfaces listed in response_writer_feature.go.
+//
+// Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+// features must be kept in the same order as the constants of
+// response_writer_feature.go.
+var features = []struct {
+	constant string
+	iface    string
+	impl     string
+}{
+	{"flusher", "http.Flusher", "flusherFeature"},
+	{"hijacker", "http.Hijacker", "hijackerFeature"},
+	{"closeNotifier", "http.CloseNotifier", "closeNotifierFeature"},
+	{"pusher", "http.Pusher", "pusherFeature"},
+	{"stringWriter", "io.StringWriter", "stringWriterFeature"},
+	{"flushError", "errorFlusher", "flushErrorFeature"},
+	{"readDeadline", "readDeadlineSetter", "readDeadlineFeature"},
+	{"writeDeadline", "writeDeadlineSetter", "writeDeadlineFeature"},
+	{"fullDuplex", "fullDuplexEnabler", "fullDuplexFeature"},
+}
+
+// pickers lists the types wrapped by the generated feature pickers. The
+// implementations of a feature are named after the one of responseWriter,
+// prefixed with the prefix of the picker.
+var pickers = []struct {
+	file   string
+	init   string
+	picker string
+	typ    string
+	prefix string
+}{
+	{"response_writer_feature_generated.go", "initFeaturePicker", "featurePicker", "responseWriter", ""},
+	{"buffer_feature_generated.go", "initBufferedFeaturePicker", "bufferedFeaturePicker", "BufferedResponseWriter", "buffered"},
+}
+
+func main() {
+	for _, p := range pickers {
+		generate(p.file, p.init, p.picker, p.typ, p.prefix)
+	}
+}
+
+func generate(file, init, picker, typ, prefix string) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `// Code generated by featuregen.go; DO NOT EDIT.
+
+package negroni
+
+import (
+	"io"
+	"net/http"
+)
+
+func %s() {
+	%s[0] = func(w *%s) ResponseWriter {
+		return w
+	}
+`, init, picker, typ)
+
+	for combination := 1; combination < 1<<len(features); combination++ {
+		var constants, fields, values []string
+		for i, f := range features {
+			if combination&(1<<i) == 0 {
+				continue
+			}
+			impl := f.impl
+			if prefix != "" {
+				impl = prefix + strings.ToUpper(impl[:1]) + impl[1:]
+			}
+			constants = append(constants, f.constant)
+			fields = append(fields, f.iface)
+			values = append(values, impl+"{w}")
+		}
+
+		fmt.Fprintf(&buf, "\t%s[%s] = func(w *%s) ResponseWriter {\n", picker, strings.Join(constants, "|"), typ)
+		fmt.Fprintf(&buf, "\t\treturn struct {\n\t\t\t*%s\n", typ)
+		for _, field := range fields {
+			fmt.Fprintf(&buf, "\t\t\t%s\n", field)
+		}
+		fmt.Fprintf(&buf, "\t\t}{w, %s}\n\t}\n", strings.Join(values, ", "))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(file, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
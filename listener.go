@@ -0,0 +1,61 @@
+package negroni
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// listenFDsStart is the first file descriptor passed by systemd socket
+	// activation (SD_LISTEN_FDS_START).
+	listenFDsStart = 3
+)
+
+// listen creates a listener for addr. Besides TCP "host:port" addresses it
+// understands "unix:/path/to.sock" for Unix domain sockets, "fd:N" for an
+// inherited file descriptor and "systemd" for the first socket passed by
+// systemd socket activation.
+func listen(addr string) (net.Listener, error) {
+	switch {
+	case strings.HasPrefix(addr, "unix:"):
+		return net.Listen("unix", strings.TrimPrefix(addr, "unix:"))
+	case strings.HasPrefix(addr, "fd:"):
+		fd, err := strconv.Atoi(strings.TrimPrefix(addr, "fd:"))
+		if err != nil || fd < 0 {
+			return nil, fmt.Errorf("negroni: invalid file descriptor in address %q", addr)
+		}
+		return fileListener(fd)
+	case addr == "systemd":
+		if listenFDs() == 0 {
+			return nil, fmt.Errorf("negroni: no sockets passed by systemd")
+		}
+		return fileListener(listenFDsStart)
+	default:
+		return net.Listen("tcp", addr)
+	}
+}
+
+func fileListener(fd int) (net.Listener, error) {
+	f := os.NewFile(uintptr(fd), "fd:"+strconv.Itoa(fd))
+	if f == nil {
+		return nil, fmt.Errorf("negroni: invalid file descriptor %d", fd)
+	}
+	defer f.Close()
+	return net.FileListener(f)
+}
+
+// listenFDs returns the number of sockets passed to this process by systemd
+// socket activation, following the LISTEN_PID and LISTEN_FDS protocol.
+func listenFDs() int {
+	if pid, err := strconv.Atoi(os.Getenv("LISTEN_PID")); err != nil || pid != os.Getpid() {
+		return 0
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
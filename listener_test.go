@@ -0,0 +1,71 @@
+package negroni
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestListenUnix(t *testing.T) {
+	dir, err := os.MkdirTemp("", "negroni")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sock := filepath.Join(dir, "negroni.sock")
+
+	ln, err := listen("unix:" + sock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := New()
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusTeapot)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- n.ServeContext(ctx, ln)
+	}()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", sock)
+		},
+	}}
+	res, err := client.Get("http://negroni/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	expect(t, res.StatusCode, http.StatusTeapot)
+
+	cancel()
+	expect(t, <-done, nil)
+}
+
+func TestListenInvalidAddress(t *testing.T) {
+	_, err := listen("fd:foo")
+	refute(t, err, nil)
+
+	os.Unsetenv("LISTEN_PID")
+	_, err = listen("systemd")
+	refute(t, err, nil)
+}
+
+func TestListenFDs(t *testing.T) {
+	defer os.Unsetenv("LISTEN_PID")
+	defer os.Unsetenv("LISTEN_FDS")
+
+	os.Setenv("LISTEN_FDS", "2")
+	os.Setenv("LISTEN_PID", "1")
+	expect(t, listenFDs(), 0)
+
+	os.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+	expect(t, listenFDs(), 2)
+}
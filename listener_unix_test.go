@@ -0,0 +1,37 @@
+//go:build !windows
+// +build !windows
+
+package negroni
+
+import (
+	"net"
+	"strconv"
+	"syscall"
+	"testing"
+)
+
+func TestListenFD(t *testing.T) {
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tcp.Close()
+	f, err := tcp.(*net.TCPListener).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	// listen takes ownership of the descriptor, so hand it a copy rather
+	// than the one f closes.
+	fd, err := syscall.Dup(int(f.Fd()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ln, err := listen("fd:" + strconv.Itoa(fd))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	expect(t, ln.Addr().String(), tcp.Addr().String())
+}
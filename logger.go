@@ -5,7 +5,7 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"text/template"
+	"sync"
 	"time"
 )
 
@@ -18,6 +18,53 @@ type LoggerEntry struct {
 	Method    string
 	Path      string
 	Request   *http.Request
+
+	// Size is the size of the response body, RemoteAddr the network address
+	// of the client and RequestID the value of the LoggerRequestIDHeader of
+	// the request, or else of the response.
+	Size       int
+	RemoteAddr string
+	RequestID  string
+
+	// Start is when the request started, and StartTime its formatted value.
+	Start time.Time
+	// RequestURI is the unmodified request target of the request line, and
+	// Protocol the protocol version of the request, e.g. "HTTP/1.1".
+	RequestURI string
+	Protocol   string
+	// RemoteUser is the user name sent in the URL or with basic
+	// authentication, and Referer and UserAgent the values of the
+	// corresponding request headers.
+	RemoteUser string
+	Referer    string
+	UserAgent  string
+
+	// Hijacked reports whether the connection was hijacked, e.g. upgraded
+	// to a WebSocket, in which case Status is 101 unless the handler wrote
+	// another status before.
+	Hijacked bool
+
+	// The following fields are measured from the start of the request and
+	// are zero if the event did not happen.
+	TimeToHeader    time.Duration
+	TimeToFirstByte time.Duration
+	TimeToLastWrite time.Duration
+
+	// Writes and Flushes count the Write and Flush calls on the response,
+	// and HeaderBytes and TrailerBytes approximate the size of its headers
+	// and trailers.
+	Writes       int
+	Flushes      int
+	HeaderBytes  int
+	TrailerBytes int
+
+	// RequestBody and ResponseBody hold the beginning of the bodies, if
+	// enabled with SetBodyCapture.
+	RequestBody  string
+	ResponseBody string
+
+	// Trailers holds the response trailers selected with SetTrailers.
+	Trailers http.Header
 }
 
 // LoggerDefaultFormat is the format logged used by the default Logger instance.
@@ -26,18 +73,40 @@ var LoggerDefaultFormat = "{{.StartTime}} | {{.Status}} | \t {{.Duration}} | {{.
 // LoggerDefaultDateFormat is the format used for date by the default Logger instance.
 var LoggerDefaultDateFormat = time.RFC3339
 
+// LoggerRequestIDHeader is the header holding the ID of a request.
+var LoggerRequestIDHeader = "X-Request-Id"
+
 // ALogger interface
 type ALogger interface {
 	Println(v ...interface{})
 	Printf(format string, v ...interface{})
 }
 
+// StructuredLogger receives the entries of a Logger as structured data rather
+// than as lines rendered from its template, see SetStructuredLogger.
+type StructuredLogger interface {
+	Log(entry LoggerEntry)
+}
+
 // Logger is a middleware handler that logs the request as it goes in and the response as it goes out.
 type Logger struct {
+	// The counters are accessed atomically and come first to be 64-bit
+	// aligned.
+	dropped    uint64
+	skipped    uint64
+	sampledOut uint64
+
 	// ALogger implements just enough log.Logger interface to be compatible with other implementations
 	ALogger
 	dateFormat string
-	template   *template.Template
+	encoder    LoggerEncoder
+	bodyLimit  int
+	trailers   []string
+	structured StructuredLogger
+	sampling   LoggerSampling
+
+	queueMu sync.RWMutex
+	queue   *loggerQueue
 }
 
 // NewLogger returns a new Logger instance
@@ -47,17 +116,59 @@ func NewLogger() *Logger {
 	return logger
 }
 
+// SetFormat makes the Logger render entries with a TemplateEncoder parsing
+// format.
 func (l *Logger) SetFormat(format string) {
-	l.template = template.Must(template.New("negroni_parser").Parse(format))
+	l.SetEncoder(NewTemplateEncoder(format))
+}
+
+// SetEncoder sets the LoggerEncoder rendering the entries, such as a
+// TemplateEncoder, a JSONEncoder or a LogfmtEncoder. Lines the encoder fails
+// to render are not logged.
+func (l *Logger) SetEncoder(encoder LoggerEncoder) {
+	l.encoder = encoder
 }
 
 func (l *Logger) SetDateFormat(format string) {
 	l.dateFormat = format
 }
 
+// SetBodyCapture makes the Logger capture up to limit bytes of the request and
+// response bodies into LoggerEntry.RequestBody and LoggerEntry.ResponseBody.
+// Only the part of the request body read by the handlers is captured. A limit
+// of zero disables capturing.
+func (l *Logger) SetBodyCapture(limit int) {
+	l.bodyLimit = limit
+}
+
+// SetStructuredLogger makes the Logger hand each entry to s instead of
+// rendering it with its template and printing it to its ALogger. Setting it
+// back to nil restores the template output.
+func (l *Logger) SetStructuredLogger(s StructuredLogger) {
+	l.structured = s
+}
+
+// SetTrailers makes the Logger copy the given response trailers, such as
+// grpc-status, into LoggerEntry.Trailers when they are set.
+func (l *Logger) SetTrailers(names ...string) {
+	l.trailers = make([]string, len(names))
+	for i, name := range names {
+		l.trailers[i] = http.CanonicalHeaderKey(name)
+	}
+}
+
 func (l *Logger) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
 	start := time.Now()
 
+	var reqBody, resBody *BodyCapture
+	if l.bodyLimit > 0 {
+		reqBody = CaptureRequestBody(r, l.bodyLimit)
+		resBody = NewBodyCapture(l.bodyLimit)
+		if tee, ok := rw.(TeeResponseWriter); ok {
+			tee.Tee(resBody)
+		}
+	}
+
 	next(rw, r)
 
 	res := rw.(ResponseWriter)
@@ -69,9 +180,89 @@ func (l *Logger) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.Ha
 		Method:    r.Method,
 		Path:      r.URL.Path,
 		Request:   r,
+
+		Size:       res.Size(),
+		RemoteAddr: r.RemoteAddr,
+		RequestID:  r.Header.Get(LoggerRequestIDHeader),
+
+		Start:      start,
+		RequestURI: r.RequestURI,
+		Protocol:   r.Proto,
+		RemoteUser: remoteUser(r),
+		Referer:    r.Referer(),
+		UserAgent:  r.UserAgent(),
+	}
+	if log.RequestURI == "" {
+		log.RequestURI = r.URL.RequestURI()
+	}
+	if log.RequestID == "" {
+		log.RequestID = res.Header().Get(LoggerRequestIDHeader)
+	}
+	if !l.sample(&log) {
+		return
+	}
+	if h, ok := res.(HijackedResponseWriter); ok {
+		log.Hijacked = h.Hijacked()
+	}
+	if m, ok := res.(MetricsResponseWriter); ok {
+		metrics := m.Metrics()
+		log.TimeToHeader = elapsed(start, metrics.HeaderWrittenAt)
+		log.TimeToFirstByte = elapsed(start, metrics.FirstByteAt)
+		log.TimeToLastWrite = elapsed(start, metrics.LastWriteAt)
+		log.Writes = metrics.Writes
+		log.Flushes = metrics.Flushes
+		log.HeaderBytes = metrics.HeaderBytes
+		log.TrailerBytes = metrics.TrailerBytes
+	}
+	if t, ok := res.(TrailerResponseWriter); ok && len(l.trailers) > 0 {
+		log.Trailers = selectTrailers(t.Trailer(), l.trailers)
+	}
+	if l.bodyLimit > 0 {
+		log.RequestBody = reqBody.String()
+		log.ResponseBody = resBody.String()
+	}
+
+	l.log(log)
+}
+
+// output hands entry to the StructuredLogger or prints it with the encoder.
+func (l *Logger) output(entry LoggerEntry) {
+	if l.structured != nil {
+		l.structured.Log(entry)
+		return
 	}
 
 	buff := &bytes.Buffer{}
-	l.template.Execute(buff, log)
+	if err := l.encoder.Encode(buff, entry); err != nil {
+		return
+	}
 	l.Println(buff.String())
 }
+
+func remoteUser(r *http.Request) string {
+	if r.URL.User != nil {
+		return r.URL.User.Username()
+	}
+	user, _, _ := r.BasicAuth()
+	return user
+}
+
+func selectTrailers(t http.Header, names []string) http.Header {
+	var selected http.Header
+	for _, name := range names {
+		if values, ok := t[name]; ok {
+			if selected == nil {
+				selected = make(http.Header)
+			}
+			selected[name] = values
+		}
+	}
+	return selected
+}
+
+func elapsed(start, t time.Time) time.Duration {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Sub(start)
+}
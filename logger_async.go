@@ -0,0 +1,145 @@
+package negroni
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// LoggerFullPolicy tells an asynchronous Logger what to do with an entry when
+// its queue is full, see Logger.SetAsync.
+type LoggerFullPolicy int
+
+const (
+	// LoggerBlock makes the request wait until there is room in the queue.
+	LoggerBlock LoggerFullPolicy = iota
+	// LoggerDrop drops the entry, which is counted by Logger.Dropped.
+	LoggerDrop
+)
+
+// loggerQueue is the queue of an asynchronous Logger, drained by a
+// background goroutine.
+type loggerQueue struct {
+	mu      sync.RWMutex
+	entries chan queuedEntry
+	policy  LoggerFullPolicy
+	closed  bool
+	done    chan struct{}
+}
+
+// queuedEntry is either an entry to output, or a flush marker closed once
+// the entries queued before it were output.
+type queuedEntry struct {
+	entry   LoggerEntry
+	flushed chan struct{}
+}
+
+// SetAsync makes the Logger output its entries from a background goroutine
+// instead of the request goroutine, so that a slow output does not delay the
+// responses. Up to size entries are queued, beyond which policy applies. A
+// size of zero or less restores synchronous logging.
+//
+// Entries still queued are lost if the process exits, so Close or Flush the
+// Logger before, e.g. with Negroni.RegisterOnShutdown. As they are output
+// after the request is complete, templates must not rely on the state of
+// LoggerEntry.Request that may change afterwards, such as its body.
+func (l *Logger) SetAsync(size int, policy LoggerFullPolicy) {
+	l.Close()
+	if size <= 0 {
+		return
+	}
+
+	q := &loggerQueue{
+		entries: make(chan queuedEntry, size),
+		policy:  policy,
+		done:    make(chan struct{}),
+	}
+	go func() {
+		defer close(q.done)
+		for e := range q.entries {
+			if e.flushed != nil {
+				close(e.flushed)
+				continue
+			}
+			l.output(e.entry)
+		}
+	}()
+	l.queueMu.Lock()
+	l.queue = q
+	l.queueMu.Unlock()
+}
+
+// Dropped returns the number of entries an asynchronous Logger dropped
+// because its queue was full.
+func (l *Logger) Dropped() uint64 {
+	return atomic.LoadUint64(&l.dropped)
+}
+
+// Flush waits until the entries queued by an asynchronous Logger so far are
+// output. It returns immediately for a synchronous Logger.
+func (l *Logger) Flush() {
+	q := l.currentQueue()
+	if q == nil {
+		return
+	}
+
+	q.mu.RLock()
+	if q.closed {
+		q.mu.RUnlock()
+		return
+	}
+	flushed := make(chan struct{})
+	q.entries <- queuedEntry{flushed: flushed}
+	q.mu.RUnlock()
+	<-flushed
+}
+
+// Close outputs the entries queued by an asynchronous Logger and stops its
+// background goroutine. The Logger is synchronous afterwards.
+func (l *Logger) Close() {
+	l.queueMu.Lock()
+	q := l.queue
+	l.queue = nil
+	l.queueMu.Unlock()
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	q.closed = true
+	close(q.entries)
+	q.mu.Unlock()
+	<-q.done
+}
+
+func (l *Logger) currentQueue() *loggerQueue {
+	l.queueMu.RLock()
+	defer l.queueMu.RUnlock()
+	return l.queue
+}
+
+// log outputs entry, or queues it if the Logger is asynchronous.
+func (l *Logger) log(entry LoggerEntry) {
+	q := l.currentQueue()
+	if q == nil {
+		l.output(entry)
+		return
+	}
+
+	q.mu.RLock()
+	if q.closed {
+		// Close was called concurrently.
+		q.mu.RUnlock()
+		l.output(entry)
+		return
+	}
+	if q.policy == LoggerDrop {
+		select {
+		case q.entries <- queuedEntry{entry: entry}:
+		default:
+			atomic.AddUint64(&l.dropped, 1)
+		}
+	} else {
+		q.entries <- queuedEntry{entry: entry}
+	}
+	q.mu.RUnlock()
+}
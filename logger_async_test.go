@@ -0,0 +1,128 @@
+package negroni
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// lineRecorder is an ALogger recording the lines it prints. If block is set,
+// printing waits until it is closed, and started is closed once the first
+// line is being printed.
+type lineRecorder struct {
+	mu      sync.Mutex
+	lines   []string
+	block   chan struct{}
+	started chan struct{}
+	once    sync.Once
+}
+
+func (r *lineRecorder) Println(v ...interface{}) {
+	if r.block != nil {
+		r.once.Do(func() { close(r.started) })
+		<-r.block
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lines = append(r.lines, fmt.Sprint(v...))
+}
+
+func (r *lineRecorder) Printf(format string, v ...interface{}) {
+	r.Println(fmt.Sprintf(format, v...))
+}
+
+func (r *lineRecorder) Lines() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.lines...)
+}
+
+func newAsyncTestLogger(size int, policy LoggerFullPolicy) (*Logger, *lineRecorder) {
+	lines := &lineRecorder{}
+	l := NewLogger()
+	l.ALogger = lines
+	l.SetFormat("{{.Path}}")
+	l.SetAsync(size, policy)
+	return l, lines
+}
+
+func serveLogger(l *Logger, path string) {
+	n := New(l)
+	req, _ := http.NewRequest("GET", "http://localhost:3000"+path, nil)
+	n.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestLoggerAsync(t *testing.T) {
+	l, lines := newAsyncTestLogger(16, LoggerBlock)
+	defer l.Close()
+
+	for i := 0; i < 10; i++ {
+		serveLogger(l, fmt.Sprintf("/%d", i))
+	}
+	l.Flush()
+
+	logged := lines.Lines()
+	expect(t, len(logged), 10)
+	for i, line := range logged {
+		expect(t, line, fmt.Sprintf("/%d", i))
+	}
+	expect(t, l.Dropped(), uint64(0))
+}
+
+func TestLoggerAsyncDrop(t *testing.T) {
+	l, lines := newAsyncTestLogger(1, LoggerDrop)
+	lines.block = make(chan struct{})
+	lines.started = make(chan struct{})
+
+	// The first entry is being output, the second one fills the queue and
+	// the others are dropped.
+	serveLogger(l, "/0")
+	<-lines.started
+	for i := 1; i < 4; i++ {
+		serveLogger(l, fmt.Sprintf("/%d", i))
+	}
+	expect(t, l.Dropped(), uint64(2))
+
+	close(lines.block)
+	l.Close()
+	expect(t, len(lines.Lines()), 2)
+}
+
+func TestLoggerAsyncClose(t *testing.T) {
+	l, lines := newAsyncTestLogger(16, LoggerBlock)
+	serveLogger(l, "/queued")
+	l.Close()
+	expect(t, len(lines.Lines()), 1)
+
+	// A closed Logger logs synchronously.
+	serveLogger(l, "/sync")
+	expect(t, len(lines.Lines()), 2)
+	l.Flush()
+	l.Close()
+}
+
+func TestLoggerAsyncConcurrent(t *testing.T) {
+	l, lines := newAsyncTestLogger(4, LoggerBlock)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				serveLogger(l, "/")
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		l.Flush()
+	}()
+	wg.Wait()
+	l.Close()
+
+	expect(t, len(lines.Lines()), 400)
+}
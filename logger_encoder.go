@@ -0,0 +1,223 @@
+package negroni
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+)
+
+// LoggerEncoder renders a LoggerEntry as a log line, see Logger.SetEncoder.
+type LoggerEncoder interface {
+	Encode(w io.Writer, entry LoggerEntry) error
+}
+
+// TemplateEncoder renders entries with a text/template, the fields of the
+// LoggerEntry being available to the template along with functions to escape,
+// quote and format them, as used by the LoggerCommonFormat,
+// LoggerCombinedFormat and LoggerW3CFormat. It is the default encoder of a
+// Logger, set by Logger.SetFormat.
+type TemplateEncoder struct {
+	Template *template.Template
+}
+
+// NewTemplateEncoder returns a TemplateEncoder parsing format, which must be
+// a valid template.
+func NewTemplateEncoder(format string) *TemplateEncoder {
+	return &TemplateEncoder{Template: template.Must(template.New("negroni_parser").Funcs(loggerFuncs).Parse(format))}
+}
+
+// Encode renders entry with the template.
+func (e *TemplateEncoder) Encode(w io.Writer, entry LoggerEntry) error {
+	return e.Template.Execute(w, entry)
+}
+
+// LoggerDefaultFields are the fields encoded by the JSONEncoder and the
+// LogfmtEncoder when none are selected.
+var LoggerDefaultFields = []string{"time", "status", "duration", "host", "method", "path", "size", "remote_addr", "request_id"}
+
+// loggerFields maps the names of the fields available to the JSONEncoder
+// and the LogfmtEncoder to their value in a LoggerEntry.
+var loggerFields = map[string]func(e *LoggerEntry) interface{}{
+	"time":               func(e *LoggerEntry) interface{} { return e.StartTime },
+	"status":             func(e *LoggerEntry) interface{} { return e.Status },
+	"duration":           func(e *LoggerEntry) interface{} { return e.Duration },
+	"host":               func(e *LoggerEntry) interface{} { return e.Hostname },
+	"method":             func(e *LoggerEntry) interface{} { return e.Method },
+	"path":               func(e *LoggerEntry) interface{} { return e.Path },
+	"size":               func(e *LoggerEntry) interface{} { return e.Size },
+	"remote_addr":        func(e *LoggerEntry) interface{} { return e.RemoteAddr },
+	"request_id":         func(e *LoggerEntry) interface{} { return e.RequestID },
+	"uri":                func(e *LoggerEntry) interface{} { return e.RequestURI },
+	"protocol":           func(e *LoggerEntry) interface{} { return e.Protocol },
+	"remote_user":        func(e *LoggerEntry) interface{} { return e.RemoteUser },
+	"referer":            func(e *LoggerEntry) interface{} { return e.Referer },
+	"user_agent":         func(e *LoggerEntry) interface{} { return e.UserAgent },
+	"hijacked":           func(e *LoggerEntry) interface{} { return e.Hijacked },
+	"time_to_header":     func(e *LoggerEntry) interface{} { return e.TimeToHeader },
+	"time_to_first_byte": func(e *LoggerEntry) interface{} { return e.TimeToFirstByte },
+	"time_to_last_write": func(e *LoggerEntry) interface{} { return e.TimeToLastWrite },
+	"writes":             func(e *LoggerEntry) interface{} { return e.Writes },
+	"flushes":            func(e *LoggerEntry) interface{} { return e.Flushes },
+	"header_bytes":       func(e *LoggerEntry) interface{} { return e.HeaderBytes },
+	"trailer_bytes":      func(e *LoggerEntry) interface{} { return e.TrailerBytes },
+	"request_body":       func(e *LoggerEntry) interface{} { return e.RequestBody },
+	"response_body":      func(e *LoggerEntry) interface{} { return e.ResponseBody },
+}
+
+// LoggerFields returns the names of the fields that can be selected in a
+// JSONEncoder or a LogfmtEncoder, in alphabetical order.
+func LoggerFields() []string {
+	names := make([]string, 0, len(loggerFields))
+	for name := range loggerFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// eachField calls fn with the key and value of each of the fields of entry,
+// or of the LoggerDefaultFields if there are none, renamed according to
+// rename.
+func eachField(fields []string, rename map[string]string, entry *LoggerEntry, fn func(key string, value interface{}) error) error {
+	if len(fields) == 0 {
+		fields = LoggerDefaultFields
+	}
+	for _, name := range fields {
+		value, ok := loggerFields[name]
+		if !ok {
+			return fmt.Errorf("negroni: unknown logger field %q", name)
+		}
+		key := name
+		if renamed, ok := rename[name]; ok {
+			key = renamed
+		}
+		if err := fn(key, value(entry)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSONEncoder renders each entry as a JSON object holding the selected
+// fields, in order. Durations are encoded as integer nanoseconds.
+type JSONEncoder struct {
+	// Fields are the names of the fields to encode, in order. See
+	// LoggerFields for the available names. LoggerDefaultFields are
+	// encoded if it is empty.
+	Fields []string
+	// Rename maps field names to the keys they are encoded with. Fields
+	// that are not in it keep their name.
+	Rename map[string]string
+}
+
+// NewJSONEncoder returns a JSONEncoder encoding the given fields, or the
+// LoggerDefaultFields if there are none.
+func NewJSONEncoder(fields ...string) *JSONEncoder {
+	return &JSONEncoder{Fields: fields}
+}
+
+// Encode renders entry as a JSON object.
+func (e *JSONEncoder) Encode(w io.Writer, entry LoggerEntry) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	encode := func(v interface{}) error {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		// Encode terminates each value with a newline.
+		buf.Truncate(buf.Len() - 1)
+		return nil
+	}
+
+	buf.WriteByte('{')
+	err := eachField(e.Fields, e.Rename, &entry, func(key string, value interface{}) error {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		if d, ok := value.(time.Duration); ok {
+			value = int64(d)
+		}
+		if err := encode(key); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+		return encode(value)
+	})
+	if err != nil {
+		return err
+	}
+	buf.WriteByte('}')
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// LogfmtEncoder renders each entry as a logfmt line of key=value pairs
+// holding the selected fields, in order. Values are quoted when needed and
+// durations are formatted like time.Duration.String.
+type LogfmtEncoder struct {
+	// Fields are the names of the fields to encode, in order. See
+	// LoggerFields for the available names. LoggerDefaultFields are
+	// encoded if it is empty.
+	Fields []string
+	// Rename maps field names to the keys they are encoded with. Fields
+	// that are not in it keep their name.
+	Rename map[string]string
+}
+
+// NewLogfmtEncoder returns a LogfmtEncoder encoding the given fields, or the
+// LoggerDefaultFields if there are none.
+func NewLogfmtEncoder(fields ...string) *LogfmtEncoder {
+	return &LogfmtEncoder{Fields: fields}
+}
+
+// Encode renders entry as a logfmt line.
+func (e *LogfmtEncoder) Encode(w io.Writer, entry LoggerEntry) error {
+	var buf bytes.Buffer
+	err := eachField(e.Fields, e.Rename, &entry, func(key string, value interface{}) error {
+		if buf.Len() > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(key)
+		buf.WriteByte('=')
+		switch v := value.(type) {
+		case string:
+			buf.WriteString(logfmtValue(v))
+		case int:
+			buf.WriteString(strconv.Itoa(v))
+		case bool:
+			buf.WriteString(strconv.FormatBool(v))
+		default:
+			buf.WriteString(logfmtValue(fmt.Sprint(v)))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// logfmtValue quotes s if it is empty or holds spaces, quotes, equal signs,
+// control characters or invalid UTF-8.
+func logfmtValue(s string) string {
+	if s == "" {
+		return `""`
+	}
+	if !utf8.ValidString(s) || strings.IndexFunc(s, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == 0x7f || r == utf8.RuneError
+	}) >= 0 {
+		return strconv.Quote(s)
+	}
+	return s
+}
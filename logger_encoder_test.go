@@ -0,0 +1,125 @@
+package negroni
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func encoderTestEntries() []LoggerEntry {
+	return []LoggerEntry{
+		{
+			StartTime:  "2024-06-04T10:00:00Z",
+			Status:     200,
+			Duration:   1500 * time.Microsecond,
+			Hostname:   "localhost:3000",
+			Method:     "GET",
+			Path:       "/foobar",
+			Size:       5,
+			RemoteAddr: "192.0.2.1:1234",
+			RequestID:  "abc123",
+			Start:      time.Date(2024, 6, 4, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			RequestURI: "/foobar?q=1",
+			Protocol:   "HTTP/1.1",
+			RemoteUser: "frank",
+			Referer:    "http://localhost:3000/",
+			UserAgent:  "Mozilla/5.0 (X11; Linux x86_64)",
+		},
+		{
+			StartTime:    "2024-06-04T10:00:01Z",
+			Status:       500,
+			Duration:     2 * time.Second,
+			Hostname:     "example.com",
+			Method:       "POST",
+			Path:         `/say "hello" <world>`,
+			RemoteAddr:   "[2001:db8::1]:443",
+			RequestBody:  "a=b\tc\nd",
+			ResponseBody: "caf\xc3\xa9 \xff",
+			Start:        time.Date(2024, 6, 4, 10, 0, 1, 0, time.UTC),
+			RequestURI:   "/say%20%22hello%22",
+			Protocol:     "HTTP/2.0",
+			UserAgent:    "curl \"quoted\"\n",
+		},
+	}
+}
+
+func testEncoderGolden(t *testing.T, name string, encoder LoggerEncoder) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	for _, entry := range encoderTestEntries() {
+		if err := encoder.Encode(&buf, entry); err != nil {
+			t.Fatal(err)
+		}
+		buf.WriteByte('\n')
+	}
+
+	golden := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(t, buf.String(), string(want))
+}
+
+func TestTemplateEncoder(t *testing.T) {
+	testEncoderGolden(t, "template_encoder", NewTemplateEncoder(LoggerDefaultFormat))
+}
+
+func TestTemplateEncoderCommonFormat(t *testing.T) {
+	testEncoderGolden(t, "common_format", NewTemplateEncoder(LoggerCommonFormat))
+}
+
+func TestTemplateEncoderCombinedFormat(t *testing.T) {
+	testEncoderGolden(t, "combined_format", NewTemplateEncoder(LoggerCombinedFormat))
+}
+
+func TestTemplateEncoderW3CFormat(t *testing.T) {
+	testEncoderGolden(t, "w3c_format", NewTemplateEncoder(LoggerW3CFormat))
+}
+
+func TestJSONEncoder(t *testing.T) {
+	testEncoderGolden(t, "json_encoder", NewJSONEncoder())
+}
+
+func TestJSONEncoderFields(t *testing.T) {
+	encoder := NewJSONEncoder("status", "path", "request_body", "response_body")
+	encoder.Rename = map[string]string{"status": "code", "path": "uri"}
+	testEncoderGolden(t, "json_encoder_fields", encoder)
+}
+
+func TestLogfmtEncoder(t *testing.T) {
+	testEncoderGolden(t, "logfmt_encoder", NewLogfmtEncoder())
+}
+
+func TestLogfmtEncoderFields(t *testing.T) {
+	encoder := NewLogfmtEncoder("status", "path", "duration", "request_body", "response_body")
+	encoder.Rename = map[string]string{"status": "code", "path": "uri"}
+	testEncoderGolden(t, "logfmt_encoder_fields", encoder)
+}
+
+func TestEncoderUnknownField(t *testing.T) {
+	var buf bytes.Buffer
+	refute(t, NewJSONEncoder("status", "nope").Encode(&buf, LoggerEntry{}), nil)
+	refute(t, NewLogfmtEncoder("nope").Encode(&buf, LoggerEntry{}), nil)
+	expect(t, buf.Len(), 0)
+}
+
+func TestLoggerFields(t *testing.T) {
+	fields := LoggerFields()
+	expect(t, len(fields), len(loggerFields))
+	for _, name := range LoggerDefaultFields {
+		expect(t, strings.Contains(strings.Join(fields, " "), name), true)
+	}
+}
@@ -0,0 +1,111 @@
+package negroni
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// LoggerCommonFormat renders entries in the NCSA Common Log Format, as used
+// by Apache httpd and many log analyzers.
+var LoggerCommonFormat = `{{host .RemoteAddr}} - {{.RemoteUser | dash | escape}} [{{clfTime .Start}}] "{{escape .Method}} {{escape .RequestURI}} {{escape .Protocol}}" {{.Status}} {{if .Size}}{{.Size}}{{else}}-{{end}}`
+
+// LoggerCombinedFormat renders entries in the NCSA Combined Log Format, which
+// adds the referer and the user agent to the LoggerCommonFormat.
+var LoggerCombinedFormat = LoggerCommonFormat + ` {{.Referer | dash | quote}} {{.UserAgent | dash | quote}}`
+
+// LoggerW3CFormat renders entries in the W3C Extended Log File Format, with
+// the fields declared by LoggerW3CHeader. Times are in UTC and time-taken is
+// in seconds.
+var LoggerW3CFormat = `{{w3cDate .Start}} {{w3cTime .Start}} {{host .RemoteAddr}} {{w3c .RemoteUser}} {{w3c .Method}} {{w3c .RequestURI}} {{w3c .Protocol}} {{.Status}} {{.Size}} {{seconds .Duration}} {{w3c .UserAgent}} {{w3c .Referer}}`
+
+// LoggerW3CHeader holds the directives that must start a log file written
+// with the LoggerW3CFormat.
+var LoggerW3CHeader = "#Version: 1.0\n#Fields: date time c-ip cs-username cs-method cs-uri cs-version sc-status sc-bytes time-taken cs(User-Agent) cs(Referer)"
+
+// loggerFuncs are the functions available to the templates of a Logger:
+//
+//	escape   escapes quotes, backslashes and non-printable bytes like Apache httpd
+//	quote    escapes a string and wraps it in double quotes
+//	dash     replaces an empty string by "-"
+//	host     strips the port of an address, "-" if it is empty
+//	clfTime  formats a time like 10/Oct/2000:13:55:36 -0700
+//	w3cDate  formats a time in UTC like 2000-10-10
+//	w3cTime  formats a time in UTC like 20:55:36
+//	w3c      formats a string as a W3C extended log field
+//	seconds  formats a duration in seconds, with millisecond precision
+var loggerFuncs = template.FuncMap{
+	"escape":  logEscape,
+	"quote":   func(s string) string { return `"` + logEscape(s) + `"` },
+	"dash":    logDash,
+	"host":    logHost,
+	"clfTime": func(t time.Time) string { return t.Format("02/Jan/2006:15:04:05 -0700") },
+	"w3cDate": func(t time.Time) string { return t.UTC().Format("2006-01-02") },
+	"w3cTime": func(t time.Time) string { return t.UTC().Format("15:04:05") },
+	"w3c":     logW3C,
+	"seconds": func(d time.Duration) string { return strconv.FormatFloat(d.Seconds(), 'f', 3, 64) },
+}
+
+// logEscape escapes s like Apache httpd does in its access logs: quotes and
+// backslashes are backslash-escaped, and bytes outside of printable ASCII are
+// written as \xhh.
+func logEscape(s string) string {
+	i := 0
+	for ; i < len(s); i++ {
+		if c := s[i]; c < ' ' || c > '~' || c == '"' || c == '\\' {
+			break
+		}
+	}
+	if i == len(s) {
+		return s
+	}
+
+	const hex = "0123456789abcdef"
+	var b strings.Builder
+	b.Grow(len(s) + 8)
+	b.WriteString(s[:i])
+	for ; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < ' ' || c > '~':
+			b.WriteString(`\x`)
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&0xf])
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func logDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func logHost(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return logDash(logEscape(addr))
+}
+
+// logW3C formats s as a W3C extended log field: "-" if it is empty, as is if
+// it holds no spaces nor quotes, else quoted with embedded quotes doubled.
+func logW3C(s string) string {
+	if s == "" {
+		return "-"
+	}
+	s = logEscape(s)
+	if !strings.ContainsAny(s, ` "`) {
+		return s
+	}
+	// logEscape already escaped the quotes with a backslash.
+	return `"` + strings.ReplaceAll(s, `\"`, `""`) + `"`
+}
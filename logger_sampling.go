@@ -0,0 +1,91 @@
+package negroni
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// LoggerSampling selects the requests a Logger logs, see Logger.SetSampling.
+// Responses with a 5xx status and requests taking at least SlowThreshold are
+// always logged. Of the others, those matching Skip or SkipStatus are not
+// logged, and the remaining ones are sampled at Rate.
+type LoggerSampling struct {
+	// Skip matches the requests not to log, e.g. PathPrefix("/healthz") or
+	// Methods(http.MethodOptions), combined with Any.
+	Skip Predicate
+	// SkipStatus lists the statuses of the responses not to log.
+	SkipStatus []int
+	// SlowThreshold is the duration from which requests are always
+	// logged. Zero disables it.
+	SlowThreshold time.Duration
+	// Rate is the fraction of the remaining requests to log, between 0 and
+	// 1. Zero disables sampling, i.e. logs them all. Requests with an ID
+	// are sampled by hashing it, so that the requests of a trace are all
+	// logged or all sampled out, whichever negroni instance serves them.
+	Rate float64
+}
+
+// SetSampling sets which requests the Logger logs. The zero LoggerSampling
+// logs them all.
+func (l *Logger) SetSampling(s LoggerSampling) {
+	l.sampling = s
+}
+
+// Skipped returns the number of entries that were not logged because they
+// matched LoggerSampling.Skip or LoggerSampling.SkipStatus.
+func (l *Logger) Skipped() uint64 {
+	return atomic.LoadUint64(&l.skipped)
+}
+
+// SampledOut returns the number of entries that were not logged because of
+// LoggerSampling.Rate.
+func (l *Logger) SampledOut() uint64 {
+	return atomic.LoadUint64(&l.sampledOut)
+}
+
+// sample returns whether entry must be logged, counting those that are not.
+func (l *Logger) sample(entry *LoggerEntry) bool {
+	s := &l.sampling
+	if entry.Status >= http.StatusInternalServerError {
+		return true
+	}
+	if s.SlowThreshold > 0 && entry.Duration >= s.SlowThreshold {
+		return true
+	}
+
+	if s.Skip != nil && entry.Request != nil && s.Skip(entry.Request) {
+		atomic.AddUint64(&l.skipped, 1)
+		return false
+	}
+	for _, status := range s.SkipStatus {
+		if entry.Status == status {
+			atomic.AddUint64(&l.skipped, 1)
+			return false
+		}
+	}
+
+	if s.Rate <= 0 || s.Rate >= 1 {
+		return true
+	}
+	if sampleValue(entry.RequestID) < s.Rate {
+		return true
+	}
+	atomic.AddUint64(&l.sampledOut, 1)
+	return false
+}
+
+// sampleValue maps id to a value in [0, 1), derived from its FNV-1a hash, or
+// a random one if id is empty.
+func sampleValue(id string) float64 {
+	if id == "" {
+		return rand.Float64()
+	}
+	h := fnv.New64a()
+	h.Write([]byte(id))
+	// The low bits of FNV-1a depend the most on the end of id, which tends
+	// to be where request IDs differ.
+	return float64(h.Sum64()&(1<<53-1)) / (1 << 53)
+}
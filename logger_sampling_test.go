@@ -0,0 +1,85 @@
+package negroni
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func serveSampled(l *Logger, method, path string, status int, delay time.Duration, id string) {
+	n := New(l)
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		rw.WriteHeader(status)
+	})
+	req, _ := http.NewRequest(method, "http://localhost:3000"+path, nil)
+	if id != "" {
+		req.Header.Set("X-Request-Id", id)
+	}
+	n.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestLoggerSamplingSkip(t *testing.T) {
+	lines := &lineRecorder{}
+	l := NewLogger()
+	l.ALogger = lines
+	l.SetFormat("{{.Method}} {{.Path}} {{.Status}}")
+	l.SetSampling(LoggerSampling{
+		Skip:          Any(PathPrefix("/healthz"), Methods(http.MethodOptions)),
+		SkipStatus:    []int{http.StatusNotModified},
+		SlowThreshold: 20 * time.Millisecond,
+	})
+
+	serveSampled(l, "GET", "/healthz", http.StatusOK, 0, "")
+	serveSampled(l, "OPTIONS", "/users", http.StatusNoContent, 0, "")
+	serveSampled(l, "GET", "/users", http.StatusNotModified, 0, "")
+	serveSampled(l, "GET", "/users", http.StatusOK, 0, "")
+	serveSampled(l, "GET", "/healthz", http.StatusServiceUnavailable, 0, "")
+	serveSampled(l, "GET", "/healthz", http.StatusOK, 30*time.Millisecond, "")
+
+	logged := lines.Lines()
+	expect(t, len(logged), 3)
+	expect(t, logged[0], "GET /users 200")
+	expect(t, logged[1], "GET /healthz 503")
+	expect(t, logged[2], "GET /healthz 200")
+	expect(t, l.Skipped(), uint64(3))
+	expect(t, l.SampledOut(), uint64(0))
+}
+
+func TestLoggerSamplingRate(t *testing.T) {
+	lines := &lineRecorder{}
+	l := NewLogger()
+	l.ALogger = lines
+	l.SetFormat("{{.RequestID}}")
+	l.SetSampling(LoggerSampling{Rate: 0.25})
+
+	for i := 0; i < 1000; i++ {
+		serveSampled(l, "GET", "/", http.StatusOK, 0, fmt.Sprintf("request-%d", i))
+	}
+	logged := len(lines.Lines())
+	if logged < 150 || logged > 350 {
+		t.Errorf("expected about 250 logged lines, got %d", logged)
+	}
+	expect(t, l.SampledOut(), uint64(1000-logged))
+
+	// The decision is deterministic for a request ID, and errors are
+	// always logged.
+	for _, id := range lines.Lines()[:10] {
+		serveSampled(l, "GET", "/", http.StatusOK, 0, id)
+	}
+	serveSampled(l, "GET", "/", http.StatusInternalServerError, 0, "request-x")
+	expect(t, len(lines.Lines()), logged+11)
+}
+
+func TestSampleValue(t *testing.T) {
+	expect(t, sampleValue("abc"), sampleValue("abc"))
+	refute(t, sampleValue("abc"), sampleValue("abd"))
+	for i := 0; i < 100; i++ {
+		v := sampleValue(fmt.Sprint(i))
+		if v < 0 || v >= 1 {
+			t.Fatalf("sample value %v out of [0, 1)", v)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+//go:build go1.21
+// +build go1.21
+
+package negroni
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+)
+
+// SlogLogger is a StructuredLogger emitting each entry as a log/slog record
+// with typed attributes. The level of the record depends on the status class
+// of the response: Error for 5xx, Warn for 4xx and Info otherwise.
+type SlogLogger struct {
+	// Logger receives the records. slog.Default() is used if it is nil.
+	Logger *slog.Logger
+	// Message is the message of the records, "request" if it is empty.
+	Message string
+}
+
+// NewSlogLogger returns a SlogLogger emitting records to logger.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	return &SlogLogger{Logger: logger}
+}
+
+// Log emits entry as a slog record.
+func (s *SlogLogger) Log(entry LoggerEntry) {
+	logger := s.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	ctx := context.Background()
+	if entry.Request != nil {
+		ctx = entry.Request.Context()
+	}
+	level := SlogLevel(entry.Status)
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	message := s.Message
+	if message == "" {
+		message = "request"
+	}
+	attrs := []slog.Attr{
+		slog.Int("status", entry.Status),
+		slog.Duration("duration", entry.Duration),
+		slog.String("method", entry.Method),
+		slog.String("path", entry.Path),
+		slog.String("host", entry.Hostname),
+		slog.Int("size", entry.Size),
+		slog.String("remote_addr", entry.RemoteAddr),
+	}
+	if entry.RequestID != "" {
+		attrs = append(attrs, slog.String("request_id", entry.RequestID))
+	}
+	if entry.Hijacked {
+		attrs = append(attrs, slog.Bool("hijacked", true))
+	}
+	logger.LogAttrs(ctx, level, message, attrs...)
+}
+
+// SlogLevel returns the level of the record logged for a response with the
+// given status: Error for 5xx, Warn for 4xx and Info otherwise.
+func SlogLevel(status int) slog.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return slog.LevelError
+	case status >= http.StatusBadRequest:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
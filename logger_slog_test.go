@@ -0,0 +1,70 @@
+//go:build go1.21
+// +build go1.21
+
+package negroni
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLoggerSlog(t *testing.T) {
+	var buff bytes.Buffer
+	l := NewLogger()
+	l.SetStructuredLogger(NewSlogLogger(slog.New(slog.NewJSONHandler(&buff, nil))))
+
+	n := New()
+	n.Use(l)
+	n.UseHandler(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte("not found"))
+	}))
+
+	req, err := http.NewRequest("GET", "http://localhost:3000/foobar", nil)
+	if err != nil {
+		t.Error(err)
+	}
+	req.RemoteAddr = "192.0.2.1:1234"
+	req.Header.Set("X-Request-Id", "abc123")
+
+	n.ServeHTTP(httptest.NewRecorder(), req)
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(buff.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+	expect(t, record["level"], "WARN")
+	expect(t, record["msg"], "request")
+	expect(t, record["status"], float64(http.StatusNotFound))
+	expect(t, record["method"], "GET")
+	expect(t, record["path"], "/foobar")
+	expect(t, record["host"], "localhost:3000")
+	expect(t, record["size"], float64(9))
+	expect(t, record["remote_addr"], "192.0.2.1:1234")
+	expect(t, record["request_id"], "abc123")
+	_, ok := record["duration"].(float64)
+	expect(t, ok, true)
+}
+
+func TestLoggerSlogLevelFiltered(t *testing.T) {
+	var buff bytes.Buffer
+	handler := slog.NewTextHandler(&buff, &slog.HandlerOptions{Level: slog.LevelWarn})
+	s := NewSlogLogger(slog.New(handler))
+
+	s.Log(LoggerEntry{Status: http.StatusOK})
+	expect(t, buff.Len(), 0)
+
+	s.Log(LoggerEntry{Status: http.StatusBadGateway})
+	refute(t, buff.Len(), 0)
+}
+
+func TestSlogLevel(t *testing.T) {
+	expect(t, SlogLevel(http.StatusOK), slog.LevelInfo)
+	expect(t, SlogLevel(http.StatusFound), slog.LevelInfo)
+	expect(t, SlogLevel(http.StatusNotFound), slog.LevelWarn)
+	expect(t, SlogLevel(http.StatusServiceUnavailable), slog.LevelError)
+}
@@ -84,3 +84,154 @@ func Test_LoggerCustomFormat(t *testing.T) {
 	n.ServeHTTP(recorder, req)
 	expect(t, strings.TrimSpace(buff.String()), "[negroni] bar "+userAgent+" - 200")
 }
+
+func Test_LoggerMetrics(t *testing.T) {
+	var buff bytes.Buffer
+	recorder := httptest.NewRecorder()
+
+	l := NewLogger()
+	l.ALogger = log.New(&buff, "", 0)
+	l.SetFormat("{{.Writes}} {{.Flushes}} {{.HeaderBytes}} {{gt .TimeToFirstByte 0}} {{ge .TimeToLastWrite .TimeToFirstByte}}")
+
+	n := New()
+	n.Use(l)
+	n.UseHandler(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("Hello"))
+		rw.(http.Flusher).Flush()
+		rw.Write([]byte(" world"))
+	}))
+
+	req, err := http.NewRequest("GET", "http://localhost:3000/foobar", nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	n.ServeHTTP(recorder, req)
+	expect(t, strings.TrimSpace(buff.String()), "2 1 19 true true")
+}
+
+func Test_LoggerTrailers(t *testing.T) {
+	var buff bytes.Buffer
+	recorder := httptest.NewRecorder()
+
+	l := NewLogger()
+	l.ALogger = log.New(&buff, "", 0)
+	l.SetFormat(`{{.Status}} grpc-status={{.Trailers.Get "Grpc-Status"}} {{len .Trailers}}`)
+	l.SetTrailers("grpc-status")
+
+	n := New()
+	n.Use(l)
+	n.UseHandler(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		DeclareTrailer(rw, "Grpc-Status")
+		rw.Write([]byte("Hello"))
+		rw.Header().Set("Grpc-Status", "5")
+		SetTrailer(rw, "Grpc-Message", "not found")
+	}))
+
+	req, err := http.NewRequest("GET", "http://localhost:3000/foobar", nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	n.ServeHTTP(recorder, req)
+	expect(t, strings.TrimSpace(buff.String()), "200 grpc-status=5 1")
+}
+
+type entryRecorder struct {
+	entries []LoggerEntry
+}
+
+func (e *entryRecorder) Log(entry LoggerEntry) {
+	e.entries = append(e.entries, entry)
+}
+
+func Test_LoggerStructured(t *testing.T) {
+	var buff bytes.Buffer
+	entries := &entryRecorder{}
+
+	l := NewLogger()
+	l.ALogger = log.New(&buff, "", 0)
+	l.SetStructuredLogger(entries)
+
+	n := New()
+	n.Use(l)
+	n.UseHandler(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("X-Request-Id", "from-response")
+		rw.Write([]byte("Hello"))
+	}))
+
+	req, err := http.NewRequest("GET", "http://localhost:3000/foobar", nil)
+	if err != nil {
+		t.Error(err)
+	}
+	req.RemoteAddr = "192.0.2.1:1234"
+
+	n.ServeHTTP(httptest.NewRecorder(), req)
+	expect(t, buff.Len(), 0)
+	expect(t, len(entries.entries), 1)
+	entry := entries.entries[0]
+	expect(t, entry.Status, http.StatusOK)
+	expect(t, entry.Size, 5)
+	expect(t, entry.RemoteAddr, "192.0.2.1:1234")
+	expect(t, entry.RequestID, "from-response")
+
+	l.SetStructuredLogger(nil)
+	n.ServeHTTP(httptest.NewRecorder(), req)
+	expect(t, len(entries.entries), 1)
+	refute(t, buff.Len(), 0)
+}
+
+func Test_LoggerEncoder(t *testing.T) {
+	var buff bytes.Buffer
+
+	l := NewLogger()
+	l.ALogger = log.New(&buff, "", 0)
+	l.SetEncoder(NewJSONEncoder("status", "method", "path"))
+
+	n := New()
+	n.Use(l)
+	n.UseHandler(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusCreated)
+	}))
+
+	req, err := http.NewRequest("POST", "http://localhost:3000/foobar", nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	n.ServeHTTP(httptest.NewRecorder(), req)
+	expect(t, buff.String(), `{"status":201,"method":"POST","path":"/foobar"}`+"\n")
+
+	buff.Reset()
+	l.SetEncoder(NewJSONEncoder("nope"))
+	n.ServeHTTP(httptest.NewRecorder(), req)
+	expect(t, buff.Len(), 0)
+}
+
+func Test_LoggerCombinedFormat(t *testing.T) {
+	var buff bytes.Buffer
+
+	l := NewLogger()
+	l.ALogger = log.New(&buff, "", 0)
+	l.SetFormat(LoggerCombinedFormat)
+
+	n := New()
+	n.Use(l)
+	n.UseHandler(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("Hello"))
+	}))
+
+	req, err := http.NewRequest("GET", "http://localhost:3000/foobar?q=1", nil)
+	if err != nil {
+		t.Error(err)
+	}
+	req.RemoteAddr = "192.0.2.1:1234"
+	req.SetBasicAuth("frank", "secret")
+	req.Header.Set("Referer", "http://localhost:3000/")
+	req.Header.Set("User-Agent", "Go-Test")
+
+	n.ServeHTTP(httptest.NewRecorder(), req)
+	line := strings.TrimSpace(buff.String())
+	expect(t, strings.HasPrefix(line, "192.0.2.1 - frank ["), true)
+	expect(t, strings.HasSuffix(line, `] "GET /foobar?q=1 HTTP/1.1" 200 5 "http://localhost:3000/" "Go-Test"`), true)
+}
@@ -0,0 +1,105 @@
+package negroni
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type mountPrefixKey struct{}
+
+// Mount adds a handler onto the middleware stack that serves requests whose
+// URL path is prefix or lies below it. The prefix is stripped from the
+// request's URL.Path and URL.RawPath before handler is invoked, so handler can
+// be written as if it were served from the root, and the original request is
+// left untouched for the rest of the stack. Requests outside of prefix are
+// passed along to the next middleware in the chain.
+//
+// The stripped prefix is available to handler through MountPrefix.
+func (n *Negroni) Mount(prefix string, handler http.Handler) {
+	if handler == nil {
+		panic("handler cannot be nil")
+	}
+	n.Use(&mount{prefix: strings.TrimSuffix(prefix, "/"), handler: handler})
+}
+
+// Group creates a new Negroni instance, lets fn configure it and mounts it
+// under prefix (see Mount). The new instance is returned so handlers can be
+// added to it later.
+//
+//	n.Group("/api", func(api *negroni.Negroni) {
+//		api.Use(auth)
+//		api.UseHandler(apiRouter)
+//	})
+func (n *Negroni) Group(prefix string, fn func(*Negroni)) *Negroni {
+	group := New()
+	if fn != nil {
+		fn(group)
+	}
+	n.Mount(prefix, group)
+	return group
+}
+
+// MountPrefix returns the path prefix stripped from r by Mount, including the
+// prefixes of any enclosing mounts, or an empty string if r was not routed
+// through a mount.
+func MountPrefix(r *http.Request) string {
+	prefix, _ := r.Context().Value(mountPrefixKey{}).(string)
+	return prefix
+}
+
+type mount struct {
+	prefix  string
+	handler http.Handler
+}
+
+func (m *mount) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	p := r.URL.Path
+	if m.prefix != "" && p != m.prefix && !strings.HasPrefix(p, m.prefix+"/") {
+		next(rw, r)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), mountPrefixKey{}, MountPrefix(r)+m.prefix)
+	r2 := r.WithContext(ctx)
+	r2.URL = new(url.URL)
+	*r2.URL = *r.URL
+	r2.URL.Path = stripPrefix(p, m.prefix)
+	if r.URL.RawPath != "" {
+		// The prefix may be escaped differently in the raw path, so strip
+		// as many segments as it has rather than its text.
+		raw := stripSegments(r.URL.RawPath, strings.Count(m.prefix, "/"))
+		if u, err := url.PathUnescape(raw); err == nil && u == r2.URL.Path {
+			r2.URL.RawPath = raw
+		} else {
+			// Let URL.EscapedPath derive it from the stripped path.
+			r2.URL.RawPath = ""
+		}
+	}
+	m.handler.ServeHTTP(rw, r2)
+}
+
+func stripPrefix(p, prefix string) string {
+	p = strings.TrimPrefix(p, prefix)
+	if p == "" || p[0] != '/' {
+		p = "/" + p
+	}
+	return p
+}
+
+// stripSegments removes the first n segments from the path p.
+func stripSegments(p string, n int) string {
+	for ; n > 0 && p != ""; n-- {
+		i := strings.IndexByte(p[1:], '/')
+		if i < 0 {
+			p = ""
+			break
+		}
+		p = p[i+1:]
+	}
+	if p == "" {
+		p = "/"
+	}
+	return p
+}
@@ -0,0 +1,90 @@
+package negroni
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNegroniMount(t *testing.T) {
+	var path, rawPath, prefix string
+	inner := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		rawPath = r.URL.RawPath
+		prefix = MountPrefix(r)
+		rw.WriteHeader(http.StatusAccepted)
+	})
+
+	n := New()
+	n.Mount("/api/", inner)
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusNotFound)
+	})
+
+	tests := []struct {
+		url     string
+		code    int
+		path    string
+		rawPath string
+	}{
+		{"http://localhost/api/users", http.StatusAccepted, "/users", ""},
+		{"http://localhost/api", http.StatusAccepted, "/", ""},
+		{"http://localhost/api/a%2Fb", http.StatusAccepted, "/a/b", "/a%2Fb"},
+		{"http://localhost/apiary", http.StatusNotFound, "", ""},
+		{"http://localhost/", http.StatusNotFound, "", ""},
+	}
+	for _, tt := range tests {
+		path, rawPath, prefix = "", "", ""
+		req, _ := http.NewRequest("GET", tt.url, nil)
+		originalPath := req.URL.Path
+		rec := httptest.NewRecorder()
+		n.ServeHTTP(rec, req)
+
+		expect(t, rec.Code, tt.code)
+		expect(t, path, tt.path)
+		expect(t, rawPath, tt.rawPath)
+		expect(t, req.URL.Path, originalPath)
+		if tt.code == http.StatusAccepted {
+			expect(t, prefix, "/api")
+		}
+	}
+}
+
+func TestNegroniMountEscapedPrefix(t *testing.T) {
+	var path, escapedPath string
+	n := New()
+	n.Mount("/a b", http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		escapedPath = r.URL.EscapedPath()
+	}))
+
+	req, _ := http.NewRequest("GET", "http://localhost/a%20b/x%2Fy", nil)
+	n.ServeHTTP(httptest.NewRecorder(), req)
+	expect(t, path, "/x/y")
+	expect(t, escapedPath, "/x%2Fy")
+}
+
+func TestNegroniGroup(t *testing.T) {
+	var path, prefix string
+	result := ""
+
+	n := New()
+	api := n.Group("/api", func(api *Negroni) {
+		api.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+			result += "api"
+			next(rw, r)
+		})
+	})
+	api.Group("/v1", nil).UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		prefix = MountPrefix(r)
+	})
+
+	req, _ := http.NewRequest("GET", "http://localhost/api/v1/users", nil)
+	n.ServeHTTP(httptest.NewRecorder(), req)
+
+	expect(t, result, "api")
+	expect(t, path, "/users")
+	expect(t, prefix, "/api/v1")
+	expect(t, MountPrefix(req), "")
+}
@@ -1,9 +1,13 @@
 package negroni
 
 import (
+	"crypto/tls"
 	"log"
 	"net/http"
 	"os"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
@@ -13,6 +17,7 @@ const (
 
 func init() {
 	initFeaturePicker()
+	initBufferedFeaturePicker()
 }
 
 // Handler handler is an interface that objects can implement to be registered to serve as middleware
@@ -74,24 +79,38 @@ func WrapFunc(handlerFunc http.HandlerFunc) Handler {
 // Negroni is a stack of Middleware Handlers that can be invoked as an http.Handler.
 // Negroni middleware is evaluated in the order that they are added to the stack using
 // the Use and UseHandler methods.
+//
+// The stack may be modified while it is serving requests: every modification
+// publishes a freshly built middleware chain atomically, and requests already in
+// flight finish with the chain they started with.
 type Negroni struct {
-	middleware middleware
+	// mu serializes modifications of handlers and rebuilds of the chain,
+	// and guards onShutdown.
+	mu sync.Mutex
+	// middleware holds the *middleware chain built from handlers.
+	middleware atomic.Value
 	handlers   []Handler
+	observer   Observer
+
+	shutdownTimeout time.Duration
+	tlsConfig       *tls.Config
+	h2c             bool
+	onShutdown      []func()
+	// pooling is accessed atomically, see EnablePooling.
+	pooling int32
 }
 
 // New returns a new Negroni instance with no middleware preconfigured.
 func New(handlers ...Handler) *Negroni {
-	return &Negroni{
-		handlers:   handlers,
-		middleware: build(handlers),
-	}
+	n := &Negroni{}
+	n.setHandlers(handlers)
+	return n
 }
 
 // With returns a new Negroni instance that is a combination of the negroni
 // receiver's handlers and the provided handlers.
 func (n *Negroni) With(handlers ...Handler) *Negroni {
-	currentHandlers := make([]Handler, len(n.handlers))
-	copy(currentHandlers, n.handlers)
+	currentHandlers := n.Handlers()
 	return New(
 		append(currentHandlers, handlers...)...,
 	)
@@ -107,8 +126,59 @@ func Classic() *Negroni {
 	return New(NewRecovery(), NewLogger(), NewStatic(http.Dir("public")))
 }
 
+// ServeHTTP invokes the middleware chain with a ResponseWriter wrapping rw,
+// which is taken from a pool if enabled with EnablePooling, and invokes the
+// After functions registered on it once the chain returns. If rw already is a
+// ResponseWriter, or wraps one exposed through Unwrap, its bookkeeping is
+// shared as described in NewResponseWriter. When that ResponseWriter was
+// created by the ServeHTTP of another Negroni, such as when n is nested in
+// it, the After functions are left for that one to invoke.
 func (n *Negroni) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
-	n.middleware.ServeHTTP(NewResponseWriter(rw), r)
+	if callsAfter(rw) {
+		n.middleware.Load().(*middleware).ServeHTTP(NewResponseWriter(rw), r)
+		return
+	}
+
+	shared, ok := rw.(ResponseWriter)
+	if !ok {
+		shared = unwrapResponseWriter(rw)
+	}
+	if atomic.LoadInt32(&n.pooling) == 0 {
+		w := &responseWriter{ResponseWriter: rw, shared: shared, served: true}
+		defer w.callAfter()
+		n.middleware.Load().(*middleware).ServeHTTP(wrapFeature(w), r)
+		return
+	}
+
+	w := acquireResponseWriter(rw)
+	w.shared = shared
+	w.served = true
+	completed := false
+	defer func() {
+		w.callAfter()
+		// A writer is only reused if the chain did not panic, since the
+		// panic may leave it in use elsewhere.
+		if completed {
+			releaseResponseWriter(w)
+		}
+	}()
+	n.middleware.Load().(*middleware).ServeHTTP(w.wrapped, r)
+	completed = true
+}
+
+// EnablePooling makes ServeHTTP reuse its ResponseWriter wrappers across
+// requests, so that serving a request through the bare stack does not
+// allocate. Pooling is disabled by default, as it requires that no handler
+// uses the ResponseWriter, nor lets a Before or After function, a closure or a
+// goroutine use it, once ServeHTTP returns: it would then read or modify the
+// response of another request. Writers of hijacked connections are never
+// reused.
+func (n *Negroni) EnablePooling(enabled bool) {
+	var pooling int32
+	if enabled {
+		pooling = 1
+	}
+	atomic.StoreInt32(&n.pooling, pooling)
 }
 
 // Use adds a Handler onto the middleware stack. Handlers are invoked in the order they are added to a Negroni.
@@ -117,8 +187,30 @@ func (n *Negroni) Use(handler Handler) {
 		panic("handler cannot be nil")
 	}
 
-	n.handlers = append(n.handlers, handler)
-	n.middleware = build(n.handlers)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.setHandlers(append(n.handlers, handler))
+}
+
+// SetHandlers replaces all the handlers of the middleware stack.
+func (n *Negroni) SetHandlers(handlers ...Handler) {
+	for _, handler := range handlers {
+		if handler == nil {
+			panic("handler cannot be nil")
+		}
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.setHandlers(append([]Handler(nil), handlers...))
+}
+
+// setHandlers stores handlers and publishes the middleware chain built from
+// them. n.mu must be held unless n has not been shared yet.
+func (n *Negroni) setHandlers(handlers []Handler) {
+	n.handlers = handlers
+	m := build(observe(handlers, n.observer))
+	n.middleware.Store(&m)
 }
 
 // UseFunc adds a Negroni-style handler function onto the middleware stack.
@@ -138,13 +230,25 @@ func (n *Negroni) UseHandlerFunc(handlerFunc func(rw http.ResponseWriter, r *htt
 
 // Run is a convenience function that runs the negroni stack as an HTTP
 // server. The addr string, if provided, takes the same format as http.ListenAndServe.
+// It may also be "unix:/path/to.sock" to listen on a Unix domain socket, "fd:N" to
+// use an inherited listening file descriptor, or "systemd" to use the first socket
+// passed by systemd socket activation.
 // If no address is provided but the PORT environment variable is set, the PORT value is used.
+// Otherwise, if the process was started through systemd socket activation, that socket is used.
 // If neither is provided, the address' value will equal the DefaultAddress constant.
 func (n *Negroni) Run(addr ...string) {
 	l := log.New(os.Stdout, "[negroni] ", 0)
 	finalAddr := detectAddress(addr...)
+	srv, err := n.newServer(finalAddr)
+	if err != nil {
+		l.Fatal(err)
+	}
+	ln, err := listen(finalAddr)
+	if err != nil {
+		l.Fatal(err)
+	}
 	l.Printf("listening on %s", finalAddr)
-	l.Fatal(http.ListenAndServe(finalAddr, n))
+	l.Fatal(srv.Serve(ln))
 }
 
 func detectAddress(addr ...string) string {
@@ -154,12 +258,21 @@ func detectAddress(addr ...string) string {
 	if port := os.Getenv("PORT"); port != "" {
 		return ":" + port
 	}
+	if listenFDs() > 0 {
+		return "systemd"
+	}
 	return DefaultAddress
 }
 
 // Returns a list of all the handlers in the current Negroni middleware chain.
+// The returned slice is a copy; use InsertBefore, InsertAfter, Replace and
+// Remove to modify the chain.
 func (n *Negroni) Handlers() []Handler {
-	return n.handlers
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	handlers := make([]Handler, len(n.handlers))
+	copy(handlers, n.handlers)
+	return handlers
 }
 
 func build(handlers []Handler) middleware {
@@ -1,8 +1,11 @@
 package negroni
 
 import (
+	"io"
+	"log"
 	"net/http"
 	"testing"
+	"time"
 )
 
 type voidHandler struct{}
@@ -31,3 +34,103 @@ func BenchmarkNegroni(b *testing.B) {
 		n.ServeHTTP(nil, nil)
 	}
 }
+
+func BenchmarkNegroniPooled(b *testing.B) {
+	n := New()
+	for i := 0; i < 10; i++ {
+		n.Use(&voidHandler{})
+	}
+	n.EnablePooling(true)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n.ServeHTTP(nil, nil)
+	}
+}
+
+type voidObserver struct{}
+
+func (o voidObserver) OnEnter(name string, r *http.Request) {}
+
+func (o voidObserver) OnExit(name string, r *http.Request, duration time.Duration, wroteResponse bool) {
+}
+
+func BenchmarkNegroniObserver(b *testing.B) {
+	n := New()
+	for i := 0; i < 10; i++ {
+		n.Use(&voidHandler{})
+	}
+	n.SetObserver(voidObserver{})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n.ServeHTTP(nil, nil)
+	}
+}
+
+// discardResponseWriter is a streaming-capable http.ResponseWriter that
+// discards everything written to it, to keep benchmarks free of recorder
+// allocations.
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header         { return w.header }
+func (w *discardResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardResponseWriter) WriteHeader(int)             {}
+func (w *discardResponseWriter) Flush()                      {}
+
+// benchmarkPooling runs fn with pooling disabled and enabled, as sub-benchmarks.
+func benchmarkPooling(b *testing.B, n *Negroni, fn func(b *testing.B)) {
+	for _, pooling := range []bool{false, true} {
+		name := "unpooled"
+		if pooling {
+			name = "pooled"
+		}
+		n.EnablePooling(pooling)
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			fn(b)
+		})
+	}
+}
+
+func BenchmarkNegroniClassic(b *testing.B) {
+	logger := NewLogger()
+	logger.ALogger = log.New(io.Discard, "", 0)
+	n := New(NewRecovery(), logger, NewStatic(http.Dir("public")))
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusNoContent)
+	})
+
+	rw := &discardResponseWriter{header: http.Header{}}
+	req, _ := http.NewRequest("POST", "http://localhost/", nil)
+
+	benchmarkPooling(b, n, func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			n.ServeHTTP(rw, req)
+		}
+	})
+}
+
+func BenchmarkNegroniStreaming(b *testing.B) {
+	chunk := []byte("data: negroni\n\n")
+	n := New(&voidHandler{})
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		f := rw.(http.Flusher)
+		for i := 0; i < 10; i++ {
+			rw.Write(chunk)
+			f.Flush()
+		}
+	})
+
+	rw := &discardResponseWriter{header: http.Header{}}
+
+	benchmarkPooling(b, n, func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			n.ServeHTTP(rw, nil)
+		}
+	})
+}
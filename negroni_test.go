@@ -1,10 +1,14 @@
 package negroni
 
 import (
+	"io"
+	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"strconv"
+	"sync"
 	"testing"
 )
 
@@ -173,6 +177,15 @@ func TestDetectAddress(t *testing.T) {
 	if detectAddress() != ":8080" {
 		t.Error("Expected the PORT env var with a prefixed colon")
 	}
+
+	os.Unsetenv("PORT")
+	os.Setenv("LISTEN_FDS", "1")
+	os.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+	defer os.Unsetenv("LISTEN_FDS")
+	defer os.Unsetenv("LISTEN_PID")
+	if detectAddress() != "systemd" {
+		t.Error("Expected the systemd socket activation address")
+	}
 }
 
 func voidHTTPHandlerFunc(rw http.ResponseWriter, r *http.Request) {
@@ -205,3 +218,212 @@ func TestWrapFunc(t *testing.T) {
 
 	expect(t, response.Code, http.StatusOK)
 }
+
+func TestNegroniConcurrentModification(t *testing.T) {
+	maintenance := HandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	})
+	n := New(Named("maintenance", Wrap(http.NotFoundHandler())))
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				rec := httptest.NewRecorder()
+				n.ServeHTTP(rec, (*http.Request)(nil))
+				if rec.Code != http.StatusOK && rec.Code != http.StatusNotFound && rec.Code != http.StatusServiceUnavailable {
+					t.Errorf("unexpected status %d", rec.Code)
+				}
+			}
+		}()
+	}
+
+	for j := 0; j < 100; j++ {
+		n.Replace(ByName("maintenance"), Named("maintenance", maintenance))
+		n.Use(&voidHandler{})
+		n.Describe()
+		n.SetHandlers(Named("maintenance", Wrap(http.NotFoundHandler())))
+	}
+	wg.Wait()
+
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, (*http.Request)(nil))
+	expect(t, rec.Code, http.StatusNotFound)
+	expect(t, len(n.Handlers()), 1)
+}
+
+func TestNegroniSetHandlers(t *testing.T) {
+	result := ""
+	handlers := []Handler{
+		HandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+			result += "one"
+			next(rw, r)
+		}),
+	}
+
+	n := New(NewRecovery())
+	n.SetHandlers(handlers...)
+	handlers[0] = NewRecovery()
+
+	n.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+	expect(t, result, "one")
+	expect(t, len(n.Handlers()), 1)
+}
+
+func TestNegroniNestedStacksShareResponseWriter(t *testing.T) {
+	result := ""
+	var outerRW, innerRW http.ResponseWriter
+
+	inner := New()
+	inner.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		innerRW = rw
+		rw.(ResponseWriter).Before(func(ResponseWriter) {
+			result += "inner"
+		})
+		next(rw, r)
+	})
+	inner.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("Hello"))
+		rw.Write([]byte(" world"))
+	})
+
+	outer := New()
+	outer.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		outerRW = rw
+		rw.(ResponseWriter).Before(func(ResponseWriter) {
+			result += "outer"
+		})
+		next(rw, r)
+		expect(t, rw.(ResponseWriter).Status(), http.StatusOK)
+		expect(t, rw.(ResponseWriter).Size(), 11)
+	})
+	outer.UseHandler(inner)
+
+	rec := httptest.NewRecorder()
+	outer.ServeHTTP(rec, (*http.Request)(nil))
+
+	expect(t, innerRW, outerRW)
+	expect(t, result, "innerouter")
+	expect(t, rec.Body.String(), "Hello world")
+}
+
+func TestNegroniNestedStacksThroughMount(t *testing.T) {
+	var size int
+	inner := New()
+	inner.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("mounted"))
+	})
+
+	outer := New()
+	outer.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		next(rw, r)
+		size = rw.(ResponseWriter).Size()
+	})
+	outer.Mount("/inner", inner)
+
+	req, _ := http.NewRequest("GET", "http://localhost/inner/", nil)
+	outer.ServeHTTP(httptest.NewRecorder(), req)
+	expect(t, size, len("mounted"))
+}
+
+func TestNegroniAfter(t *testing.T) {
+	result := ""
+	var status, size int
+
+	inner := New()
+	inner.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		rw.(ResponseWriter).After(func(ResponseWriter) {
+			result += "inner"
+		})
+		next(rw, r)
+	})
+	inner.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte("Hello"))
+	})
+
+	n := New()
+	n.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		rw.(ResponseWriter).After(func(w ResponseWriter) {
+			result += "outer"
+			status = w.Status()
+			size = w.Size()
+		})
+		next(rw, r)
+		result += "returned"
+	})
+	n.UseHandler(inner)
+
+	n.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+	expect(t, result, "returnedinnerouter")
+	expect(t, status, http.StatusCreated)
+	expect(t, size, 5)
+}
+
+func TestNegroniAfter_wrappedResponseWriter(t *testing.T) {
+	calls := 0
+	var status int
+	n := New()
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.(ResponseWriter).After(func(w ResponseWriter) {
+			calls++
+			status = w.Status()
+		})
+		rw.WriteHeader(http.StatusAccepted)
+	})
+
+	rec := httptest.NewRecorder()
+	rw := NewResponseWriter(rec)
+	n.ServeHTTP(rw, (*http.Request)(nil))
+	expect(t, calls, 1)
+	expect(t, status, http.StatusAccepted)
+	expect(t, rw.Status(), http.StatusAccepted)
+
+	// Through Unwrap, and with pooling.
+	n.EnablePooling(true)
+	n.ServeHTTP(unwrappingResponseWriter{NewResponseWriter(httptest.NewRecorder())}, (*http.Request)(nil))
+	expect(t, calls, 2)
+}
+
+func TestNegroniAfter_recoveredPanic(t *testing.T) {
+	var status int
+	recovery := NewRecovery()
+	recovery.Logger = log.New(io.Discard, "", 0)
+
+	n := New()
+	n.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		rw.(ResponseWriter).After(func(w ResponseWriter) {
+			status = w.Status()
+		})
+		next(rw, r)
+	})
+	n.Use(recovery)
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		panic("here is a panic!")
+	})
+
+	n.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+	expect(t, status, http.StatusInternalServerError)
+}
+
+func TestNegroniAfter_unrecoveredPanic(t *testing.T) {
+	called := false
+	n := New()
+	n.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		rw.(ResponseWriter).After(func(w ResponseWriter) {
+			called = true
+		})
+		panic("here is a panic!")
+	})
+
+	func() {
+		defer func() {
+			expect(t, recover(), "here is a panic!")
+		}()
+		n.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+	}()
+	expect(t, called, true)
+}
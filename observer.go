@@ -0,0 +1,78 @@
+package negroni
+
+import (
+	"net/http"
+	"time"
+)
+
+// Observer receives callbacks around the invocation of every handler in a
+// Negroni middleware stack, e.g. to build per-middleware latency breakdowns or
+// traces. Handlers are identified by the name reported by Describe.
+type Observer interface {
+	// OnEnter is called right before the handler is invoked.
+	OnEnter(name string, r *http.Request)
+	// OnExit is called once the handler returns. duration is the time spent
+	// in the handler itself, excluding the time spent in the rest of the
+	// chain after it called next. wroteResponse reports whether the handler
+	// itself, rather than a later handler, committed the response.
+	OnExit(name string, r *http.Request, duration time.Duration, wroteResponse bool)
+}
+
+// SetObserver sets the Observer notified around every handler of the stack.
+// Passing nil removes the observer, in which case the middleware chain runs
+// without any instrumentation overhead.
+func (n *Negroni) SetObserver(observer Observer) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.observer = observer
+	n.setHandlers(n.handlers)
+}
+
+// observe wraps handlers so they notify observer, or returns them unchanged if
+// observer is nil.
+func observe(handlers []Handler, observer Observer) []Handler {
+	if observer == nil {
+		return handlers
+	}
+
+	observed := make([]Handler, len(handlers))
+	for i, h := range handlers {
+		observed[i] = &observedHandler{
+			handler:  h,
+			name:     describeHandler(i, h).Name,
+			observer: observer,
+		}
+	}
+	return observed
+}
+
+type observedHandler struct {
+	handler  Handler
+	name     string
+	observer Observer
+}
+
+func (h *observedHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	res, _ := rw.(ResponseWriter)
+	writtenBefore := written(res)
+
+	var downstream time.Duration
+	var downstreamWrote bool
+
+	h.observer.OnEnter(h.name, r)
+	start := time.Now()
+	h.handler.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+		writtenBefore := written(res)
+		start := time.Now()
+		next(rw, r)
+		downstream += time.Since(start)
+		downstreamWrote = downstreamWrote || (!writtenBefore && written(res))
+	})
+	duration := time.Since(start) - downstream
+
+	h.observer.OnExit(h.name, r, duration, !writtenBefore && written(res) && !downstreamWrote)
+}
+
+func written(res ResponseWriter) bool {
+	return res != nil && res.Written()
+}
@@ -0,0 +1,65 @@
+package negroni
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type recordingObserver struct {
+	events []string
+}
+
+func (o *recordingObserver) OnEnter(name string, r *http.Request) {
+	o.events = append(o.events, "enter "+name)
+}
+
+func (o *recordingObserver) OnExit(name string, r *http.Request, duration time.Duration, wroteResponse bool) {
+	if duration < 0 {
+		o.events = append(o.events, "negative duration "+name)
+	}
+	o.events = append(o.events, fmt.Sprintf("exit %s %v", name, wroteResponse))
+}
+
+func TestNegroniSetObserver(t *testing.T) {
+	o := &recordingObserver{}
+	n := New()
+	n.Use(Named("outer", HandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		next(rw, r)
+	})))
+	n.Use(Named("auth", HandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		rw.WriteHeader(http.StatusUnauthorized)
+	})))
+	n.Use(Named("app", HandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		t.Error("short-circuited handler should not run")
+	})))
+	n.SetObserver(o)
+
+	n.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+
+	expected := []string{
+		"enter outer",
+		"enter auth",
+		"exit auth true",
+		"exit outer false",
+	}
+	expect(t, fmt.Sprint(o.events), fmt.Sprint(expected))
+
+	o.events = nil
+	n.SetObserver(nil)
+	n.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+	expect(t, len(o.events), 0)
+}
+
+func TestNegroniSetObserver_keptOnModification(t *testing.T) {
+	o := &recordingObserver{}
+	n := New()
+	n.SetObserver(o)
+	n.Use(Named("app", Wrap(http.NotFoundHandler())))
+
+	n.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+	expect(t, fmt.Sprint(o.events), fmt.Sprint([]string{"enter app", "exit app true"}))
+	expect(t, n.Describe()[0].Name, "app")
+}
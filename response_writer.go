@@ -3,6 +3,7 @@ package negroni
 import (
 	"io"
 	"net/http"
+	"sync"
 )
 
 // ResponseWriter is a wrapper around http.ResponseWriter that provides extra information about
@@ -20,35 +21,176 @@ type ResponseWriter interface {
 	Size() int
 	// Before allows for a function to be called before the ResponseWriter has been written to. This is
 	// useful for setting headers or any other operations that must happen before a response has been written.
+	// Before functions are not called for interim 1xx responses, nor once the connection was hijacked.
 	Before(func(ResponseWriter))
+	// After allows for a function to be called once the middleware chain has finished handling the
+	// request, even if a panic occurred. This is useful for auditing, metrics or cleanup code that
+	// needs the final Status and Size. After functions are invoked by Negroni.ServeHTTP, in the
+	// reverse order they were registered.
+	After(func(ResponseWriter))
 }
 
 type beforeFunc func(ResponseWriter)
 
-// NewResponseWriter creates a ResponseWriter that wraps a http.ResponseWriter
+type afterFunc func(ResponseWriter)
+
+// NewResponseWriter creates a ResponseWriter that wraps a http.ResponseWriter.
+// If rw already is a ResponseWriter, e.g. because a Negroni stack is nested in
+// another one, it is returned as is, so the response's status, size and Before
+// callbacks are tracked in one place.
+//
+// If rw wraps a ResponseWriter that it exposes through Unwrap, such as a
+// compressing writer installed by a middleware, the returned ResponseWriter
+// writes through rw but shares the bookkeeping of the inner ResponseWriter:
+// its status, size, Before and After functions, metrics, tees and trailers.
 func NewResponseWriter(rw http.ResponseWriter) ResponseWriter {
+	if nrw, ok := rw.(ResponseWriter); ok {
+		return nrw
+	}
+
 	nrw := &responseWriter{
 		ResponseWriter: rw,
+		shared:         unwrapResponseWriter(rw),
 	}
 
 	return wrapFeature(nrw)
 }
 
+// unwrapResponseWriter returns the first ResponseWriter found by unwrapping
+// rw, or nil if there is none.
+func unwrapResponseWriter(rw http.ResponseWriter) ResponseWriter {
+	for {
+		u, ok := rw.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return nil
+		}
+		rw = u.Unwrap()
+		if nrw, ok := rw.(ResponseWriter); ok {
+			return nrw
+		}
+	}
+}
+
 type responseWriter struct {
 	http.ResponseWriter
 	pendingStatus  int
 	status         int
 	size           int
 	beforeFuncs    []beforeFunc
+	afterFuncs     []afterFunc
 	callingBefores bool
+	hijacked       bool
+	served         bool // created by Negroni.ServeHTTP, which invokes the afterFuncs
+	metrics        ResponseMetrics
+
+	// extras holds the state of the rarely used features, allocated on
+	// first use so that the common case does not pay for it.
+	extras *responseWriterExtras
+
+	// shared is the ResponseWriter wrapped by ResponseWriter, if any, which
+	// keeps the bookkeeping of the response. The methods only pass the
+	// calls through to ResponseWriter, see NewResponseWriter.
+	shared ResponseWriter
+}
+
+// responseWriterExtras holds the state of a responseWriter that only some
+// responses need.
+type responseWriterExtras struct {
+	hijack        HijackInfo
+	tee           io.Writer
+	informational []InformationalResponse
+}
+
+// extra returns the responseWriterExtras of rw, allocating them if needed.
+func (rw *responseWriter) extra() *responseWriterExtras {
+	if rw.extras == nil {
+		rw.extras = &responseWriterExtras{}
+	}
+	return rw.extras
+}
+
+// teeWriter returns the writer the body is mirrored to, or nil if there is
+// none.
+func (rw *responseWriter) teeWriter() io.Writer {
+	if rw.extras == nil {
+		return nil
+	}
+	return rw.extras.tee
+}
+
+// pooledResponseWriter keeps a responseWriter together with the feature
+// wrapper built around it, so both can be reused across requests.
+type pooledResponseWriter struct {
+	responseWriter
+	wrapped ResponseWriter
+	feature int
+}
+
+// responseWriterPools holds a pool of pooledResponseWriters for each
+// combination of features.
+var responseWriterPools = make([]sync.Pool, len(featurePicker))
+
+// acquireResponseWriter returns a pooled ResponseWriter wrapping rw. It must be
+// handed back with releaseResponseWriter once the response is complete, and
+// must not be used anymore after that.
+func acquireResponseWriter(rw http.ResponseWriter) *pooledResponseWriter {
+	feature := detectFeatures(rw)
+	w, _ := responseWriterPools[feature].Get().(*pooledResponseWriter)
+	if w == nil {
+		return newPooledResponseWriter(rw, feature)
+	}
+	w.ResponseWriter = rw
+	return w
+}
+
+// newPooledResponseWriter returns a new pooledResponseWriter wrapping rw, which
+// has the given features.
+func newPooledResponseWriter(rw http.ResponseWriter, feature int) *pooledResponseWriter {
+	w := &pooledResponseWriter{feature: feature}
+	w.ResponseWriter = rw
+	w.wrapped = featurePicker[feature](&w.responseWriter)
+	return w
+}
+
+// releaseResponseWriter resets w and puts it back into its pool. Writers of
+// hijacked connections are left to the garbage collector, since the handler
+// that took the connection over may still hold on to them.
+func releaseResponseWriter(w *pooledResponseWriter) {
+	if w.hijacked {
+		return
+	}
+
+	befores := w.beforeFuncs
+	for i := range befores {
+		befores[i] = nil
+	}
+	afters := w.afterFuncs
+	for i := range afters {
+		afters[i] = nil
+	}
+	w.responseWriter = responseWriter{beforeFuncs: befores[:0], afterFuncs: afters[:0]}
+	responseWriterPools[w.feature].Put(w)
 }
 
 func (rw *responseWriter) WriteHeader(s int) {
+	if rw.shared != nil {
+		rw.ResponseWriter.WriteHeader(s)
+		return
+	}
 	if rw.Written() {
 		return
 	}
 
 	rw.pendingStatus = s
+	if isInformational(s) {
+		// Interim responses do not commit the response, so the
+		// rw.beforeFuncs are kept for the final one.
+		rw.status = s
+		rw.recordInformational(s)
+		rw.ResponseWriter.WriteHeader(s)
+		return
+	}
+
 	rw.callBefore()
 
 	// Any of the rw.beforeFuncs may have written a header,
@@ -58,16 +200,29 @@ func (rw *responseWriter) WriteHeader(s int) {
 	}
 
 	rw.status = s
+	if rw.Written() {
+		rw.recordHeader(s)
+	}
 	rw.ResponseWriter.WriteHeader(s)
 }
 
 func (rw *responseWriter) Write(b []byte) (int, error) {
+	if rw.hijacked {
+		return 0, http.ErrHijacked
+	}
+	if rw.shared != nil {
+		return rw.ResponseWriter.Write(b)
+	}
 	if !rw.Written() {
 		// The status will be StatusOK if WriteHeader has not been called yet
 		rw.WriteHeader(http.StatusOK)
 	}
 	size, err := rw.ResponseWriter.Write(b)
 	rw.size += size
+	rw.metrics.recordWrite(size)
+	if tee := rw.teeWriter(); tee != nil {
+		tee.Write(b[:size])
+	}
 	return size, err
 }
 
@@ -75,12 +230,22 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 // io.ReaderFrom, it can take advantage of optimizations such as sendfile, io.Copy
 // with sync.Pool's buffer which is in http.(*response).ReadFrom and so on.
 func (rw *responseWriter) ReadFrom(r io.Reader) (n int64, err error) {
+	if rw.hijacked {
+		return 0, http.ErrHijacked
+	}
+	if rw.shared != nil {
+		return io.Copy(rw.ResponseWriter, r)
+	}
 	if !rw.Written() {
 		// The status will be StatusOK if WriteHeader has not been called yet
 		rw.WriteHeader(http.StatusOK)
 	}
+	if tee := rw.teeWriter(); tee != nil {
+		r = io.TeeReader(r, tee)
+	}
 	n, err = io.Copy(rw.ResponseWriter, r)
 	rw.size += int(n)
+	rw.metrics.recordWrite(int(n))
 	return
 }
 
@@ -90,6 +255,9 @@ func (rw *responseWriter) Unwrap() http.ResponseWriter {
 }
 
 func (rw *responseWriter) Status() int {
+	if rw.shared != nil {
+		return rw.shared.Status()
+	}
 	if rw.Written() {
 		return rw.status
 	}
@@ -98,17 +266,75 @@ func (rw *responseWriter) Status() int {
 }
 
 func (rw *responseWriter) Size() int {
+	if rw.shared != nil {
+		return rw.shared.Size()
+	}
 	return rw.size
 }
 
 func (rw *responseWriter) Written() bool {
+	if rw.shared != nil {
+		return rw.shared.Written()
+	}
 	return rw.status >= http.StatusOK || rw.status == http.StatusSwitchingProtocols // treat all 1xx codes aside from SwitchingProtocols as non-terminal
 }
 
+// isInformational returns whether s is an interim 1xx status code, i.e. any
+// 1xx code aside from SwitchingProtocols, which is terminal.
+func isInformational(s int) bool {
+	return s >= 100 && s < 200 && s != http.StatusSwitchingProtocols
+}
+
 func (rw *responseWriter) Before(before func(ResponseWriter)) {
+	if rw.shared != nil {
+		rw.shared.Before(before)
+		return
+	}
 	rw.beforeFuncs = append(rw.beforeFuncs, before)
 }
 
+func (rw *responseWriter) After(after func(ResponseWriter)) {
+	if rw.shared != nil && !rw.served {
+		rw.shared.After(after)
+		return
+	}
+	rw.afterFuncs = append(rw.afterFuncs, after)
+}
+
+// afterCaller is implemented by the ResponseWriters of this package, to tell
+// whether Negroni.ServeHTTP invokes the After functions registered on them.
+type afterCaller interface {
+	callsAfter() bool
+}
+
+func (rw *responseWriter) callsAfter() bool {
+	if rw.served || rw.shared == nil {
+		return rw.served
+	}
+	return callsAfter(rw.shared)
+}
+
+// callsAfter returns whether rw, or a ResponseWriter it wraps, was created by
+// Negroni.ServeHTTP, which then invokes the After functions registered on rw.
+func callsAfter(rw http.ResponseWriter) bool {
+	for {
+		if c, ok := rw.(afterCaller); ok {
+			return c.callsAfter()
+		}
+		u, ok := rw.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return false
+		}
+		rw = u.Unwrap()
+	}
+}
+
+func (rw *responseWriter) callAfter() {
+	for i := len(rw.afterFuncs) - 1; i >= 0; i-- {
+		rw.afterFuncs[i](rw)
+	}
+}
+
 func (rw *responseWriter) callBefore() {
 	// Don't recursively call before() functions, to avoid infinite looping if
 	// one of them calls rw.WriteHeader again.
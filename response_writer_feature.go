@@ -2,96 +2,148 @@ package negroni
 
 import (
 	"bufio"
+	"io"
 	"net"
 	"net/http"
+	"time"
 )
 
+//go:generate go run featuregen.go
+
+// Optional interfaces of the wrapped http.ResponseWriter that a ResponseWriter
+// only implements if the wrapped writer does, so type assertions and
+// http.ResponseController behave as they would on the wrapped writer.
 const (
 	flusher = 1 << iota
 	hijacker
 	closeNotifier
+	pusher
+	stringWriter
+	flushError
+	readDeadline
+	writeDeadline
+	fullDuplex
+
+	allFeatures = 1<<iota - 1
+)
+
+// The optional methods looked up by http.ResponseController.
+type (
+	errorFlusher interface {
+		FlushError() error
+	}
+	readDeadlineSetter interface {
+		SetReadDeadline(deadline time.Time) error
+	}
+	writeDeadlineSetter interface {
+		SetWriteDeadline(deadline time.Time) error
+	}
+	fullDuplexEnabler interface {
+		EnableFullDuplex() error
+	}
 )
 
 type (
 	flusherFeature       struct{ *responseWriter }
 	hijackerFeature      struct{ *responseWriter }
 	closeNotifierFeature struct{ *responseWriter }
+	pusherFeature        struct{ *responseWriter }
+	stringWriterFeature  struct{ *responseWriter }
+	flushErrorFeature    struct{ *responseWriter }
+	readDeadlineFeature  struct{ *responseWriter }
+	writeDeadlineFeature struct{ *responseWriter }
+	fullDuplexFeature    struct{ *responseWriter }
 )
 
 func (f flusherFeature) Flush() {
+	if f.hijacked {
+		return
+	}
+	if f.shared != nil {
+		f.ResponseWriter.(http.Flusher).Flush()
+		return
+	}
 	if !f.Written() {
 		// The status will be StatusOK if WriteHeader has not been called yet
 		f.WriteHeader(http.StatusOK)
 	}
+	f.metrics.Flushes++
 	f.ResponseWriter.(http.Flusher).Flush()
 }
 
 func (f hijackerFeature) Hijack() (net.Conn, *bufio.ReadWriter, error) {
-	return f.ResponseWriter.(http.Hijacker).Hijack()
+	conn, brw, err := f.ResponseWriter.(http.Hijacker).Hijack()
+	if err == nil {
+		f.recordHijack()
+	}
+	return conn, brw, err
 }
 
 func (f closeNotifierFeature) CloseNotify() <-chan bool {
 	return f.ResponseWriter.(http.CloseNotifier).CloseNotify()
 }
 
-var featurePicker = make([]func(writer *responseWriter) ResponseWriter, 8)
-
-func initFeaturePicker() {
-	featurePicker[0] = func(w *responseWriter) ResponseWriter {
-		return w
-	}
-	featurePicker[flusher] = func(w *responseWriter) ResponseWriter {
-		return struct {
-			*responseWriter
-			http.Flusher
-		}{w, flusherFeature{w}}
-	}
-	featurePicker[hijacker] = func(w *responseWriter) ResponseWriter {
-		return struct {
-			*responseWriter
-			http.Hijacker
-		}{w, hijackerFeature{w}}
-	}
-	featurePicker[closeNotifier] = func(w *responseWriter) ResponseWriter {
-		return struct {
-			*responseWriter
-			http.Flusher
-		}{w, flusherFeature{w}}
-	}
-	featurePicker[flusher|hijacker] = func(w *responseWriter) ResponseWriter {
-		return struct {
-			*responseWriter
-			http.Flusher
-			http.Hijacker
-		}{w, flusherFeature{w}, hijackerFeature{w}}
-	}
-	featurePicker[flusher|closeNotifier] = func(w *responseWriter) ResponseWriter {
-		return struct {
-			*responseWriter
-			http.Flusher
-			http.CloseNotifier
-		}{w, flusherFeature{w}, closeNotifierFeature{w}}
-	}
-	featurePicker[hijacker|closeNotifier] = func(w *responseWriter) ResponseWriter {
-		return struct {
-			*responseWriter
-			http.Hijacker
-			http.CloseNotifier
-		}{w, hijackerFeature{w}, closeNotifierFeature{w}}
-	}
-	featurePicker[flusher|hijacker|closeNotifier] = func(w *responseWriter) ResponseWriter {
-		return struct {
-			*responseWriter
-			http.Flusher
-			http.Hijacker
-			http.CloseNotifier
-		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}}
+func (f pusherFeature) Push(target string, opts *http.PushOptions) error {
+	return f.ResponseWriter.(http.Pusher).Push(target, opts)
+}
+
+func (f stringWriterFeature) WriteString(s string) (int, error) {
+	if f.hijacked {
+		return 0, http.ErrHijacked
 	}
+	if f.shared != nil {
+		return f.ResponseWriter.(io.StringWriter).WriteString(s)
+	}
+	if !f.Written() {
+		// The status will be StatusOK if WriteHeader has not been called yet
+		f.WriteHeader(http.StatusOK)
+	}
+	size, err := f.ResponseWriter.(io.StringWriter).WriteString(s)
+	f.size += size
+	f.metrics.recordWrite(size)
+	if tee := f.teeWriter(); tee != nil {
+		io.WriteString(tee, s[:size])
+	}
+	return size, err
 }
 
+func (f flushErrorFeature) FlushError() error {
+	if f.hijacked {
+		return http.ErrHijacked
+	}
+	if f.shared != nil {
+		return f.ResponseWriter.(errorFlusher).FlushError()
+	}
+	if !f.Written() {
+		// The status will be StatusOK if WriteHeader has not been called yet
+		f.WriteHeader(http.StatusOK)
+	}
+	f.metrics.Flushes++
+	return f.ResponseWriter.(errorFlusher).FlushError()
+}
+
+func (f readDeadlineFeature) SetReadDeadline(deadline time.Time) error {
+	return f.ResponseWriter.(readDeadlineSetter).SetReadDeadline(deadline)
+}
+
+func (f writeDeadlineFeature) SetWriteDeadline(deadline time.Time) error {
+	return f.ResponseWriter.(writeDeadlineSetter).SetWriteDeadline(deadline)
+}
+
+func (f fullDuplexFeature) EnableFullDuplex() error {
+	return f.ResponseWriter.(fullDuplexEnabler).EnableFullDuplex()
+}
+
+// featurePicker holds, for each combination of features, a function wrapping
+// a responseWriter into a ResponseWriter implementing exactly these features.
+// It is filled in by initFeaturePicker, generated by featuregen.go.
+var featurePicker = make([]func(writer *responseWriter) ResponseWriter, allFeatures+1)
+
 func wrapFeature(w *responseWriter) ResponseWriter {
-	rw := w.ResponseWriter
+	return featurePicker[detectFeatures(w.ResponseWriter)](w)
+}
 
+func detectFeatures(rw http.ResponseWriter) int {
 	feature := 0
 	if _, ok := rw.(http.Flusher); ok {
 		feature |= flusher
@@ -102,6 +154,23 @@ func wrapFeature(w *responseWriter) ResponseWriter {
 	if _, ok := rw.(http.CloseNotifier); ok {
 		feature |= closeNotifier
 	}
-
-	return featurePicker[feature](w)
+	if _, ok := rw.(http.Pusher); ok {
+		feature |= pusher
+	}
+	if _, ok := rw.(io.StringWriter); ok {
+		feature |= stringWriter
+	}
+	if _, ok := rw.(errorFlusher); ok {
+		feature |= flushError
+	}
+	if _, ok := rw.(readDeadlineSetter); ok {
+		feature |= readDeadline
+	}
+	if _, ok := rw.(writeDeadlineSetter); ok {
+		feature |= writeDeadline
+	}
+	if _, ok := rw.(fullDuplexEnabler); ok {
+		feature |= fullDuplex
+	}
+	return feature
 }
@@ -0,0 +1,4873 @@
+// Code generated by featuregen.go; DO NOT EDIT.
+
+package negroni
+
+import (
+	"io"
+	"net/http"
+)
+
+func initFeaturePicker() {
+	featurePicker[0] = func(w *responseWriter) ResponseWriter {
+		return w
+	}
+	featurePicker[flusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+		}{w, flusherFeature{w}}
+	}
+	featurePicker[hijacker] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+		}{w, hijackerFeature{w}}
+	}
+	featurePicker[flusher|hijacker] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+		}{w, flusherFeature{w}, hijackerFeature{w}}
+	}
+	featurePicker[closeNotifier] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+		}{w, closeNotifierFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+		}{w, flusherFeature{w}, closeNotifierFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}}
+	}
+	featurePicker[pusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+		}{w, pusherFeature{w}}
+	}
+	featurePicker[flusher|pusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+		}{w, flusherFeature{w}, pusherFeature{w}}
+	}
+	featurePicker[hijacker|pusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+		}{w, hijackerFeature{w}, pusherFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+		}{w, closeNotifierFeature{w}, pusherFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}}
+	}
+	featurePicker[stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+		}{w, stringWriterFeature{w}}
+	}
+	featurePicker[flusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+		}{w, flusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+		}{w, hijackerFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[pusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+		}{w, pusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}}
+	}
+	featurePicker[flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			errorFlusher
+		}{w, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			errorFlusher
+		}{w, flusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[hijacker|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			errorFlusher
+		}{w, hijackerFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|hijacker|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			errorFlusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[closeNotifier|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			errorFlusher
+		}{w, closeNotifierFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			errorFlusher
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[pusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			errorFlusher
+		}{w, pusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|pusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			errorFlusher
+		}{w, flusherFeature{w}, pusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[hijacker|pusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+		}{w, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			errorFlusher
+		}{w, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			errorFlusher
+		}{w, flusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+		}{w, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|flushError] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}}
+	}
+	featurePicker[readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			readDeadlineSetter
+		}{w, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			readDeadlineSetter
+		}{w, closeNotifierFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			readDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[pusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			readDeadlineSetter
+		}{w, pusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			readDeadlineSetter
+		}{w, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, flusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			errorFlusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+		}{w, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[pusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|flushError|readDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}}
+	}
+	featurePicker[writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			writeDeadlineSetter
+		}{w, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[pusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			writeDeadlineSetter
+		}{w, pusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			errorFlusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			errorFlusher
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[pusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|flushError|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[pusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[pusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|flushError|readDeadline|writeDeadline] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}}
+	}
+	featurePicker[fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			fullDuplexEnabler
+		}{w, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			errorFlusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			errorFlusher
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|flushError|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|flushError|readDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|flushError|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[pusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|pusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|pusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|pusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[closeNotifier|pusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|closeNotifier|pusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[hijacker|closeNotifier|pusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+	featurePicker[flusher|hijacker|closeNotifier|pusher|stringWriter|flushError|readDeadline|writeDeadline|fullDuplex] = func(w *responseWriter) ResponseWriter {
+		return struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+			http.Pusher
+			io.StringWriter
+			errorFlusher
+			readDeadlineSetter
+			writeDeadlineSetter
+			fullDuplexEnabler
+		}{w, flusherFeature{w}, hijackerFeature{w}, closeNotifierFeature{w}, pusherFeature{w}, stringWriterFeature{w}, flushErrorFeature{w}, readDeadlineFeature{w}, writeDeadlineFeature{w}, fullDuplexFeature{w}}
+	}
+}
@@ -0,0 +1,40 @@
+//go:build go1.20
+// +build go1.20
+
+package negroni
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestResponseWriterFlushError(t *testing.T) {
+	full := &fullResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+	rw := NewResponseWriter(full)
+
+	expect(t, http.NewResponseController(rw).Flush(), nil)
+	expect(t, rw.Written(), true)
+	expect(t, rw.(MetricsResponseWriter).Metrics().Flushes, 1)
+	expect(t, full.calls[len(full.calls)-1], "FlushError")
+}
+
+func TestResponseWriterResponseControllerNotSupported(t *testing.T) {
+	rw := NewResponseWriter(httptest.NewRecorder())
+	_, ok := rw.(readDeadlineSetter)
+	expect(t, ok, false)
+
+	err := http.NewResponseController(rw).SetReadDeadline(time.Time{})
+	expect(t, errors.Is(err, http.ErrNotSupported), true)
+}
+
+func TestResponseWriterHijackInfoThroughResponseController(t *testing.T) {
+	rw := NewResponseWriter(newHijackableResponse())
+	http.NewResponseController(rw).Hijack()
+
+	// The frames of http.ResponseController are skipped.
+	info := rw.(HijackedResponseWriter).HijackInfo()
+	expect(t, info.Function, "github.com/urfave/negroni/v3.TestResponseWriterHijackInfoThroughResponseController")
+}
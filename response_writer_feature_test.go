@@ -0,0 +1,139 @@
+package negroni
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fullResponseWriter implements every optional interface supported by the
+// feature wrappers and records which methods were called.
+type fullResponseWriter struct {
+	*httptest.ResponseRecorder
+	calls []string
+}
+
+func (w *fullResponseWriter) call(name string) { w.calls = append(w.calls, name) }
+
+func (w *fullResponseWriter) Flush() { w.call("Flush") }
+func (w *fullResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.call("Hijack")
+	return nil, nil, nil
+}
+func (w *fullResponseWriter) CloseNotify() <-chan bool { w.call("CloseNotify"); return nil }
+func (w *fullResponseWriter) Push(target string, opts *http.PushOptions) error {
+	w.call("Push")
+	return nil
+}
+func (w *fullResponseWriter) WriteString(s string) (int, error) {
+	w.call("WriteString")
+	return w.ResponseRecorder.WriteString(s)
+}
+func (w *fullResponseWriter) FlushError() error { w.call("FlushError"); return nil }
+func (w *fullResponseWriter) SetReadDeadline(deadline time.Time) error {
+	w.call("SetReadDeadline")
+	return nil
+}
+func (w *fullResponseWriter) SetWriteDeadline(deadline time.Time) error {
+	w.call("SetWriteDeadline")
+	return nil
+}
+func (w *fullResponseWriter) EnableFullDuplex() error { w.call("EnableFullDuplex"); return nil }
+
+// featureChecks describes, for every feature, how to detect it on a writer and
+// how to exercise it. They are listed in the order of the feature constants.
+var featureChecks = []struct {
+	feature int
+	method  string
+	has     func(http.ResponseWriter) bool
+	call    func(http.ResponseWriter)
+}{
+	{flusher, "Flush",
+		func(w http.ResponseWriter) bool { _, ok := w.(http.Flusher); return ok },
+		func(w http.ResponseWriter) { w.(http.Flusher).Flush() }},
+	{hijacker, "Hijack",
+		func(w http.ResponseWriter) bool { _, ok := w.(http.Hijacker); return ok },
+		func(w http.ResponseWriter) { w.(http.Hijacker).Hijack() }},
+	{closeNotifier, "CloseNotify",
+		func(w http.ResponseWriter) bool { _, ok := w.(http.CloseNotifier); return ok },
+		func(w http.ResponseWriter) { w.(http.CloseNotifier).CloseNotify() }},
+	{pusher, "Push",
+		func(w http.ResponseWriter) bool { _, ok := w.(http.Pusher); return ok },
+		func(w http.ResponseWriter) { w.(http.Pusher).Push("/app.js", nil) }},
+	{stringWriter, "WriteString",
+		func(w http.ResponseWriter) bool { _, ok := w.(io.StringWriter); return ok },
+		func(w http.ResponseWriter) { w.(io.StringWriter).WriteString("Hello") }},
+	{flushError, "FlushError",
+		func(w http.ResponseWriter) bool { _, ok := w.(errorFlusher); return ok },
+		func(w http.ResponseWriter) { w.(errorFlusher).FlushError() }},
+	{readDeadline, "SetReadDeadline",
+		func(w http.ResponseWriter) bool { _, ok := w.(readDeadlineSetter); return ok },
+		func(w http.ResponseWriter) { w.(readDeadlineSetter).SetReadDeadline(time.Time{}) }},
+	{writeDeadline, "SetWriteDeadline",
+		func(w http.ResponseWriter) bool { _, ok := w.(writeDeadlineSetter); return ok },
+		func(w http.ResponseWriter) { w.(writeDeadlineSetter).SetWriteDeadline(time.Time{}) }},
+	{fullDuplex, "EnableFullDuplex",
+		func(w http.ResponseWriter) bool { _, ok := w.(fullDuplexEnabler); return ok },
+		func(w http.ResponseWriter) { w.(fullDuplexEnabler).EnableFullDuplex() }},
+}
+
+func TestFeatureChecksCoverAllFeatures(t *testing.T) {
+	all := 0
+	for _, check := range featureChecks {
+		all |= check.feature
+	}
+	expect(t, all, allFeatures)
+	expect(t, len(featurePicker), allFeatures+1)
+}
+
+func TestResponseWriterFeatureCombinations(t *testing.T) {
+	for combination := 0; combination <= allFeatures; combination++ {
+		full := &fullResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+
+		// The picked wrapper must implement exactly the features of its
+		// combination.
+		underlying := featurePicker[combination](&responseWriter{ResponseWriter: full})
+		for _, check := range featureChecks {
+			if check.has(underlying) != (combination&check.feature != 0) {
+				t.Fatalf("combination %#x: wrong %s support", combination, check.method)
+			}
+		}
+
+		// Wrapping a writer with that combination must reflect it exactly
+		// and pass the calls through.
+		expect(t, detectFeatures(underlying), combination)
+		rw := wrapFeature(&responseWriter{ResponseWriter: underlying})
+		for _, check := range featureChecks {
+			if check.has(rw) != (combination&check.feature != 0) {
+				t.Fatalf("combination %#x: wrong %s support after wrapping", combination, check.method)
+			}
+			if combination&check.feature == 0 {
+				continue
+			}
+
+			// Use a fresh chain for each call, as a hijacked writer
+			// rejects any further use.
+			full.calls = nil
+			fresh := featurePicker[combination](&responseWriter{ResponseWriter: full})
+			check.call(wrapFeature(&responseWriter{ResponseWriter: fresh}))
+			if len(full.calls) != 1 || full.calls[0] != check.method {
+				t.Fatalf("combination %#x: %s called %v", combination, check.method, full.calls)
+			}
+		}
+	}
+}
+
+func TestResponseWriterWriteString(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rw := NewResponseWriter(rec)
+
+	io.WriteString(rw, "Hello world")
+	expect(t, rec.Body.String(), "Hello world")
+	expect(t, rw.Status(), http.StatusOK)
+	expect(t, rw.Size(), 11)
+	expect(t, rw.(MetricsResponseWriter).Metrics().Writes, 1)
+}
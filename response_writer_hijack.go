@@ -0,0 +1,79 @@
+package negroni
+
+import (
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// HijackInfo describes when and where the connection of a response was
+// hijacked.
+type HijackInfo struct {
+	// At is when the connection was hijacked.
+	At time.Time
+	// Function, File and Line locate the code that called Hijack, outside of
+	// negroni and net/http.
+	Function string
+	File     string
+	Line     int
+}
+
+// HijackedResponseWriter is a ResponseWriter tracking whether its connection
+// was hijacked, such as the writers created by NewResponseWriter.
+//
+// Once hijacked, the response is considered written: Status reports
+// http.StatusSwitchingProtocols unless a final status was written before,
+// WriteHeader does nothing and does not call the Before functions, and Write
+// returns http.ErrHijacked.
+type HijackedResponseWriter interface {
+	ResponseWriter
+	// Hijacked returns whether the connection was hijacked.
+	Hijacked() bool
+	// HijackInfo returns when and where the connection was hijacked, or the
+	// zero HijackInfo if it was not.
+	HijackInfo() HijackInfo
+}
+
+func (rw *responseWriter) Hijacked() bool {
+	if h, ok := rw.shared.(HijackedResponseWriter); ok && h.Hijacked() {
+		return true
+	}
+	return rw.hijacked
+}
+
+func (rw *responseWriter) HijackInfo() HijackInfo {
+	if h, ok := rw.shared.(HijackedResponseWriter); ok && h.Hijacked() {
+		return h.HijackInfo()
+	}
+	if rw.extras == nil {
+		return HijackInfo{}
+	}
+	return rw.extras.hijack
+}
+
+// recordHijack is called by Hijack once the connection was taken over.
+func (rw *responseWriter) recordHijack() {
+	rw.hijacked = true
+	hijack := &rw.extra().hijack
+	*hijack = HijackInfo{At: time.Now()}
+	if !rw.Written() {
+		rw.status = http.StatusSwitchingProtocols
+	}
+
+	// Skip runtime.Callers, recordHijack and Hijack.
+	var pcs [16]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs[:])])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "net/http.") {
+			hijack.Function = frame.Function
+			hijack.File = frame.File
+			hijack.Line = frame.Line
+			break
+		}
+		if !more {
+			break
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package negroni
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResponseWriterHijacked(t *testing.T) {
+	hijackable := newHijackableResponse()
+	rw := NewResponseWriter(hijackable).(HijackedResponseWriter)
+	befores := 0
+	rw.Before(func(ResponseWriter) {
+		befores++
+	})
+	expect(t, rw.Hijacked(), false)
+	expect(t, rw.HijackInfo(), HijackInfo{})
+
+	start := time.Now()
+	rw.(http.Hijacker).Hijack()
+
+	expect(t, rw.Hijacked(), true)
+	expect(t, rw.Written(), true)
+	expect(t, rw.Status(), http.StatusSwitchingProtocols)
+	info := rw.HijackInfo()
+	expect(t, info.At.Before(start), false)
+	expect(t, info.Function, "github.com/urfave/negroni/v3.TestResponseWriterHijacked")
+	expect(t, strings.HasSuffix(info.File, "response_writer_hijack_test.go"), true)
+
+	rw.WriteHeader(http.StatusOK)
+	_, err := rw.Write([]byte("Hello"))
+	expect(t, err, http.ErrHijacked)
+	expect(t, befores, 0)
+	expect(t, rw.Status(), http.StatusSwitchingProtocols)
+	expect(t, rw.Size(), 0)
+}
+
+func TestResponseWriterHijackedAfterStatus(t *testing.T) {
+	rw := NewResponseWriter(newHijackableResponse()).(HijackedResponseWriter)
+	rw.WriteHeader(http.StatusOK)
+	rw.(http.Hijacker).Hijack()
+
+	expect(t, rw.Hijacked(), true)
+	expect(t, rw.Status(), http.StatusOK)
+}
+
+func TestLoggerHijacked(t *testing.T) {
+	var buff bytes.Buffer
+
+	l := NewLogger()
+	l.ALogger = log.New(&buff, "", 0)
+	l.SetFormat("{{.Status}} {{if .Hijacked}}upgraded{{else}}served{{end}}")
+
+	n := New()
+	n.Use(l)
+	n.UseHandler(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.(http.Hijacker).Hijack()
+	}))
+
+	req, err := http.NewRequest("GET", "http://localhost:3000/ws", nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	n.ServeHTTP(newHijackableResponse(), req)
+	expect(t, strings.TrimSpace(buff.String()), "101 upgraded")
+}
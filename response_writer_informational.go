@@ -0,0 +1,55 @@
+package negroni
+
+import (
+	"net/http"
+)
+
+// InformationalResponse is an interim 1xx response, such as 103 Early Hints,
+// sent before the final response.
+type InformationalResponse struct {
+	Status int
+	Header http.Header
+}
+
+// InformationalResponseWriter is a ResponseWriter recording the interim
+// responses sent before the final one, such as the writers created by
+// NewResponseWriter.
+type InformationalResponseWriter interface {
+	ResponseWriter
+	// Informational returns the interim responses sent so far, in order,
+	// along with the headers they were sent with.
+	Informational() []InformationalResponse
+}
+
+func (rw *responseWriter) Informational() []InformationalResponse {
+	if i, ok := rw.shared.(InformationalResponseWriter); ok {
+		return i.Informational()
+	}
+	if rw.extras == nil {
+		return nil
+	}
+	return rw.extras.informational
+}
+
+func (rw *responseWriter) recordInformational(s int) {
+	extras := rw.extra()
+	extras.informational = append(extras.informational, InformationalResponse{
+		Status: s,
+		Header: rw.ResponseWriter.Header().Clone(),
+	})
+}
+
+// EarlyHints sends a 103 Early Hints interim response announcing links, which
+// are Link header values such as `</style.css>; rel=preload; as=style`, so
+// clients can start fetching them while the final response is prepared. The
+// links are added to the headers of rw and are thus also part of the final
+// response.
+func EarlyHints(rw http.ResponseWriter, links []string) {
+	if len(links) == 0 {
+		return
+	}
+	for _, link := range links {
+		rw.Header().Add("Link", link)
+	}
+	rw.WriteHeader(http.StatusEarlyHints)
+}
@@ -0,0 +1,107 @@
+package negroni
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// informationalRecorder records the interim responses that
+// httptest.ResponseRecorder would treat as final.
+type informationalRecorder struct {
+	*httptest.ResponseRecorder
+	interim []int
+	links   [][]string
+}
+
+func newInformationalRecorder() *informationalRecorder {
+	return &informationalRecorder{ResponseRecorder: httptest.NewRecorder()}
+}
+
+func (r *informationalRecorder) WriteHeader(code int) {
+	if code >= 100 && code < 200 && code != http.StatusSwitchingProtocols {
+		r.interim = append(r.interim, code)
+		r.links = append(r.links, r.Header().Values("Link"))
+		return
+	}
+	r.ResponseRecorder.WriteHeader(code)
+}
+
+func TestResponseWriterInformational(t *testing.T) {
+	rec := newInformationalRecorder()
+	rw := NewResponseWriter(rec)
+	befores := 0
+	rw.Before(func(w ResponseWriter) {
+		befores++
+	})
+
+	rw.WriteHeader(http.StatusContinue)
+	rw.Header().Set("Link", "</app.css>; rel=preload; as=style")
+	rw.WriteHeader(http.StatusEarlyHints)
+	expect(t, befores, 0)
+	expect(t, rw.Written(), false)
+
+	rw.Header().Set("Link", "</app.js>; rel=preload; as=script")
+	rw.WriteHeader(http.StatusOK)
+	expect(t, befores, 1)
+	expect(t, rw.Status(), http.StatusOK)
+
+	informational := rw.(InformationalResponseWriter).Informational()
+	expect(t, len(informational), 2)
+	expect(t, informational[0].Status, http.StatusContinue)
+	expect(t, informational[0].Header.Get("Link"), "")
+	expect(t, informational[1].Status, http.StatusEarlyHints)
+	expect(t, informational[1].Header.Get("Link"), "</app.css>; rel=preload; as=style")
+	expect(t, len(rec.interim), 2)
+}
+
+func TestResponseWriterBeforeFuncCannotFinalizeInformational(t *testing.T) {
+	rec := newInformationalRecorder()
+	rw := NewResponseWriter(rec)
+	rw.Before(func(w ResponseWriter) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rw.WriteHeader(http.StatusEarlyHints)
+	expect(t, rw.Written(), false)
+	expect(t, len(rec.interim), 1)
+}
+
+func TestEarlyHints(t *testing.T) {
+	rec := newInformationalRecorder()
+	rw := NewResponseWriter(rec)
+
+	EarlyHints(rw, nil)
+	expect(t, len(rec.interim), 0)
+
+	EarlyHints(rw, []string{"</a.css>; rel=preload; as=style", "</b.js>; rel=preload; as=script"})
+	expect(t, len(rec.interim), 1)
+	expect(t, rec.interim[0], http.StatusEarlyHints)
+	expect(t, len(rec.links[0]), 2)
+	expect(t, rw.Written(), false)
+}
+
+func TestStaticEarlyHints(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html></html>"), 0600)
+
+	var names []string
+	s := NewStatic(http.Dir(dir))
+	s.EarlyHints = func(name string) []string {
+		names = append(names, name)
+		return []string{"</app.css>; rel=preload; as=style"}
+	}
+
+	rec := newInformationalRecorder()
+	req, _ := http.NewRequest("GET", "http://localhost/", nil)
+	New(s).ServeHTTP(rec, req)
+
+	expect(t, len(names), 1)
+	expect(t, names[0], "/index.html")
+	expect(t, len(rec.interim), 1)
+	expect(t, rec.Code, http.StatusOK)
+	expect(t, rec.Body.String(), "<html></html>")
+	expect(t, rec.Header().Get("Link"), "</app.css>; rel=preload; as=style")
+}
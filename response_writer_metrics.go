@@ -0,0 +1,78 @@
+package negroni
+
+import (
+	"net/http"
+	"time"
+)
+
+// ResponseMetrics holds timing and volume information about a response,
+// recorded as it is written.
+type ResponseMetrics struct {
+	// HeaderWrittenAt is when the final status code and headers were
+	// committed, or the zero time if they have not been yet.
+	HeaderWrittenAt time.Time
+	// FirstByteAt is when the first byte of the body was written.
+	FirstByteAt time.Time
+	// LastWriteAt is when the body was last written to.
+	LastWriteAt time.Time
+	// Writes is the number of Write and ReadFrom calls.
+	Writes int
+	// Flushes is the number of Flush calls.
+	Flushes int
+	// HeaderBytes approximates the size of the status line and headers,
+	// as they would be sent over HTTP/1.1.
+	HeaderBytes int
+	// TrailerBytes approximates the size of the trailers set so far, which
+	// are not included in the body size reported by ResponseWriter.Size.
+	TrailerBytes int
+}
+
+// MetricsResponseWriter is a ResponseWriter recording ResponseMetrics, such as
+// the writers created by NewResponseWriter.
+type MetricsResponseWriter interface {
+	ResponseWriter
+	// Metrics returns the metrics recorded so far.
+	Metrics() ResponseMetrics
+}
+
+func (rw *responseWriter) Metrics() ResponseMetrics {
+	if m, ok := rw.shared.(MetricsResponseWriter); ok {
+		return m.Metrics()
+	}
+	metrics := rw.metrics
+	metrics.TrailerBytes = fieldBytes(rw.Trailer())
+	return metrics
+}
+
+func (rw *responseWriter) recordHeader(status int) {
+	// "HTTP/1.1 " + status code + " " + reason + CRLF
+	n := len("HTTP/1.1 000 \r\n") + len(http.StatusText(status))
+	n += fieldBytes(rw.ResponseWriter.Header())
+	// The empty line ending the header section.
+	n += 2
+
+	rw.metrics.HeaderWrittenAt = time.Now()
+	rw.metrics.HeaderBytes = n
+}
+
+func (m *ResponseMetrics) recordWrite(n int) {
+	now := time.Now()
+	m.Writes++
+	m.LastWriteAt = now
+	if n > 0 && m.FirstByteAt.IsZero() {
+		m.FirstByteAt = now
+	}
+}
+
+// fieldBytes approximates the size of the header or trailer fields h, as they
+// would be sent over HTTP/1.1.
+func fieldBytes(h http.Header) int {
+	n := 0
+	for key, values := range h {
+		for _, value := range values {
+			// key + ": " + value + CRLF
+			n += len(key) + len(value) + 4
+		}
+	}
+	return n
+}
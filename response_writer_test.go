@@ -2,10 +2,12 @@ package negroni
 
 import (
 	"bufio"
+	"bytes"
 	"io"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -317,3 +319,220 @@ func TestResponseWriterWithReadFrom(t *testing.T) {
 	expect(t, mrw.Body.String(), writeString)
 	expect(t, mrw.writtenStr, writeString)
 }
+
+func TestResponseWriterPoolReset(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := acquireResponseWriter(rec)
+	w.wrapped.Before(func(ResponseWriter) {})
+	w.wrapped.Write([]byte("Hello world"))
+	expect(t, w.Size(), 11)
+
+	releaseResponseWriter(w)
+	expect(t, w.ResponseWriter, nil)
+	expect(t, w.Status(), 0)
+	expect(t, w.Size(), 0)
+	expect(t, w.Written(), false)
+	expect(t, len(w.beforeFuncs), 0)
+	expect(t, cap(w.beforeFuncs) > 0, true)
+	expect(t, w.beforeFuncs[:1][0] == nil, true)
+}
+
+func TestResponseWriterPoolSkipsHijacked(t *testing.T) {
+	hijackable := newHijackableResponse()
+	w := acquireResponseWriter(hijackable)
+	w.wrapped.(http.Hijacker).Hijack()
+	expect(t, w.hijacked, true)
+
+	releaseResponseWriter(w)
+	expect(t, w.ResponseWriter, http.ResponseWriter(hijackable))
+}
+
+func TestNegroniServeHTTPReusesResponseWriter(t *testing.T) {
+	var sizes []int
+	n := New()
+	n.EnablePooling(true)
+	n.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		next(rw, r)
+		sizes = append(sizes, rw.(ResponseWriter).Size())
+	})
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("Hello"))
+	})
+
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		n.ServeHTTP(rec, (*http.Request)(nil))
+		expect(t, rec.Body.String(), "Hello")
+	}
+	expect(t, len(sizes), 3)
+	for _, size := range sizes {
+		expect(t, size, 5)
+	}
+}
+
+func TestNegroniServeHTTPEscapedResponseWriter(t *testing.T) {
+	var escaped []ResponseWriter
+	n := New()
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		escaped = append(escaped, rw.(ResponseWriter))
+		rw.WriteHeader(http.StatusCreated + len(escaped))
+	})
+
+	first := httptest.NewRecorder()
+	n.ServeHTTP(first, (*http.Request)(nil))
+	second := httptest.NewRecorder()
+	n.ServeHTTP(second, (*http.Request)(nil))
+
+	// Without pooling, a reference kept past ServeHTTP still belongs to its
+	// own request.
+	expect(t, escaped[0] == escaped[1], false)
+	expect(t, escaped[0].Status(), http.StatusCreated+1)
+	escaped[0].Write([]byte("late"))
+	expect(t, first.Body.String(), "late")
+	expect(t, second.Body.Len(), 0)
+	expect(t, escaped[1].Status(), http.StatusCreated+2)
+}
+
+func TestNewResponseWriterReusesResponseWriter(t *testing.T) {
+	rw := NewResponseWriter(httptest.NewRecorder())
+	expect(t, NewResponseWriter(rw), rw)
+
+	flushable := NewResponseWriter(httptest.NewRecorder())
+	_, ok := NewResponseWriter(flushable).(http.Flusher)
+	expect(t, ok, true)
+}
+
+type unwrappingResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (u unwrappingResponseWriter) Unwrap() http.ResponseWriter {
+	return u.ResponseWriter
+}
+
+// upperResponseWriter upper-cases the body, like a middleware transforming
+// the response would.
+type upperResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (u upperResponseWriter) Write(b []byte) (int, error) {
+	return u.ResponseWriter.Write(bytes.ToUpper(b))
+}
+
+func (u upperResponseWriter) Unwrap() http.ResponseWriter {
+	return u.ResponseWriter
+}
+
+func TestNewResponseWriterSharesUnwrapped(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := newPooledResponseWriter(rec, detectFeatures(rec))
+	inner := w.wrapped
+	rw := NewResponseWriter(unwrappingResponseWriter{upperResponseWriter{inner}})
+	refute(t, rw, inner)
+
+	var calls []string
+	inner.Before(func(ResponseWriter) { calls = append(calls, "inner") })
+	rw.Before(func(ResponseWriter) { calls = append(calls, "outer") })
+	rw.After(func(ResponseWriter) { calls = append(calls, "after") })
+
+	rw.Write([]byte("Hello"))
+	expect(t, rec.Body.String(), "HELLO")
+	expect(t, rw.Status(), http.StatusOK)
+	expect(t, rw.Written(), true)
+	expect(t, rw.Size(), 5)
+	expect(t, inner.Size(), 5)
+	expect(t, rw.(MetricsResponseWriter).Metrics().Writes, 1)
+
+	// Before functions run once, in the inner writer, last registered first.
+	expect(t, strings.Join(calls, " "), "outer inner")
+	w.callAfter()
+	expect(t, strings.Join(calls, " "), "outer inner after")
+}
+
+// flushingResponseWriter forwards flushes to the writer it wraps.
+type flushingResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (f flushingResponseWriter) Flush() {
+	f.ResponseWriter.(http.Flusher).Flush()
+}
+
+func (f flushingResponseWriter) FlushError() error {
+	return f.ResponseWriter.(errorFlusher).FlushError()
+}
+
+func (f flushingResponseWriter) Unwrap() http.ResponseWriter {
+	return f.ResponseWriter
+}
+
+func TestNewResponseWriterSharesUnwrappedFlushes(t *testing.T) {
+	full := &fullResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+	inner := NewResponseWriter(full)
+	w := &responseWriter{ResponseWriter: flushingResponseWriter{inner}, shared: inner}
+
+	flusherFeature{w}.Flush()
+	flushErrorFeature{w}.FlushError()
+	expect(t, strings.Join(full.calls, ","), "Flush,FlushError")
+	expect(t, w.metrics.Flushes, 0)
+	expect(t, w.Metrics().Flushes, 2)
+}
+
+func TestNegroniServeHTTPSharesUnwrapped(t *testing.T) {
+	var calls []string
+	nested := New()
+	nested.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		rw.(ResponseWriter).Before(func(ResponseWriter) { calls = append(calls, "nested before") })
+		rw.(ResponseWriter).After(func(ResponseWriter) { calls = append(calls, "nested after") })
+		next(rw, r)
+	})
+	nested.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte("Hello"))
+	})
+
+	var size int
+	n := New()
+	n.UseFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		rw.(ResponseWriter).Before(func(ResponseWriter) { calls = append(calls, "outer before") })
+		next(upperResponseWriter{rw}, r)
+		size = rw.(ResponseWriter).Size()
+		calls = append(calls, "outer done")
+	})
+	n.UseHandler(nested)
+
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, (*http.Request)(nil))
+
+	expect(t, rec.Body.String(), "HELLO")
+	expect(t, size, 5)
+	expect(t, strings.Join(calls, ", "), "nested before, outer before, outer done, nested after")
+}
+
+func TestResponseWriterMetrics(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rw := NewResponseWriter(rec)
+	m := rw.(MetricsResponseWriter)
+
+	expect(t, m.Metrics(), ResponseMetrics{})
+
+	rw.Header().Set("Content-Type", "text/plain")
+	rw.WriteHeader(http.StatusOK)
+	metrics := m.Metrics()
+	expect(t, metrics.HeaderWrittenAt.IsZero(), false)
+	expect(t, metrics.HeaderBytes, len("HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\n\r\n"))
+
+	rw.Write(nil)
+	expect(t, m.Metrics().FirstByteAt.IsZero(), true)
+
+	rw.Write([]byte("Hello"))
+	io.Copy(rw, &mockReader{readStr: " world"})
+	rw.(http.Flusher).Flush()
+
+	metrics = m.Metrics()
+	expect(t, metrics.Writes, 3)
+	expect(t, metrics.Flushes, 1)
+	expect(t, metrics.FirstByteAt.IsZero(), false)
+	expect(t, metrics.LastWriteAt.Before(metrics.FirstByteAt), false)
+	expect(t, metrics.FirstByteAt.Before(metrics.HeaderWrittenAt), false)
+}
@@ -0,0 +1,69 @@
+package negroni
+
+import (
+	"net/http"
+	"strings"
+)
+
+// TrailerResponseWriter is a ResponseWriter giving access to the trailers set
+// on the response, such as the writers created by NewResponseWriter.
+type TrailerResponseWriter interface {
+	ResponseWriter
+	// Trailer returns the trailers set so far, either declared in the
+	// Trailer header or set with the http.TrailerPrefix, or nil if there
+	// are none. Modifying the returned header does not change the response.
+	Trailer() http.Header
+}
+
+func (rw *responseWriter) Trailer() http.Header {
+	if t, ok := rw.shared.(TrailerResponseWriter); ok {
+		return t.Trailer()
+	}
+	return trailers(rw.ResponseWriter.Header())
+}
+
+// DeclareTrailer announces the trailers names in the Trailer header of the
+// response. It must be called before the response is written, so that
+// the values set with SetTrailer or in the header map once the body has been
+// written are sent as trailers.
+func DeclareTrailer(rw http.ResponseWriter, names ...string) {
+	for _, name := range names {
+		rw.Header().Add("Trailer", http.CanonicalHeaderKey(name))
+	}
+}
+
+// SetTrailer sets the trailer name of the response to value. It can be called
+// at any time, including after the body has been written and for trailers
+// that were not declared with DeclareTrailer.
+func SetTrailer(rw http.ResponseWriter, name, value string) {
+	// Header.Set would not canonicalize the prefixed key.
+	rw.Header()[http.TrailerPrefix+http.CanonicalHeaderKey(name)] = []string{value}
+}
+
+// trailers extracts the trailers from h the same way net/http does once the
+// handler returns: values set with the http.TrailerPrefix first, overridden by
+// the values of the trailers declared in the Trailer header.
+func trailers(h http.Header) http.Header {
+	var t http.Header
+	set := func(name string, values []string) {
+		if t == nil {
+			t = make(http.Header)
+		}
+		t[name] = append([]string(nil), values...)
+	}
+
+	for key, values := range h {
+		if strings.HasPrefix(key, http.TrailerPrefix) {
+			set(http.CanonicalHeaderKey(key[len(http.TrailerPrefix):]), values)
+		}
+	}
+	for _, declared := range h["Trailer"] {
+		for _, name := range strings.Split(declared, ",") {
+			name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+			if values, ok := h[name]; ok && name != "" {
+				set(name, values)
+			}
+		}
+	}
+	return t
+}
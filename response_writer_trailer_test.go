@@ -0,0 +1,61 @@
+package negroni
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResponseWriterTrailer(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rw := NewResponseWriter(rec).(TrailerResponseWriter)
+	expect(t, rw.Trailer() == nil, true)
+
+	DeclareTrailer(rw, "grpc-status", "grpc-message")
+	rw.Write([]byte("hello"))
+	rw.Header().Set("Grpc-Status", "0")
+	SetTrailer(rw, "grpc-message", "pending")
+	SetTrailer(rw, "x-checksum", "abc")
+
+	trailer := rw.Trailer()
+	expect(t, len(trailer), 3)
+	expect(t, trailer.Get("Grpc-Status"), "0")
+	expect(t, trailer.Get("Grpc-Message"), "pending")
+	expect(t, trailer.Get("X-Checksum"), "abc")
+
+	// Declared trailers take precedence over prefixed ones.
+	rw.Header().Set("Grpc-Message", "ok")
+	expect(t, rw.Trailer().Get("Grpc-Message"), "ok")
+
+	// The returned header is a copy.
+	trailer.Set("X-Checksum", "def")
+	expect(t, rw.Trailer().Get("X-Checksum"), "abc")
+
+	expect(t, rw.Size(), 5)
+	expect(t, rw.(MetricsResponseWriter).Metrics().TrailerBytes, len("Grpc-Status: 0\r\nX-Checksum: abc\r\nGrpc-Message: ok\r\n"))
+}
+
+func TestResponseWriterTrailerSent(t *testing.T) {
+	n := New()
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		DeclareTrailer(rw, "Grpc-Status")
+		rw.Write([]byte("hello"))
+		rw.Header().Set("Grpc-Status", "0")
+		SetTrailer(rw, "X-Checksum", "abc")
+	})
+
+	srv := httptest.NewServer(n)
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+
+	expect(t, string(body), "hello")
+	expect(t, res.Trailer.Get("Grpc-Status"), "0")
+	expect(t, res.Trailer.Get("X-Checksum"), "abc")
+}
@@ -0,0 +1,196 @@
+package negroni
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultShutdownTimeout is how long RunContext waits for in-flight
+	// requests to complete once a shutdown has been triggered.
+	DefaultShutdownTimeout = 10 * time.Second
+)
+
+// SetShutdownTimeout sets how long RunContext waits for in-flight requests to
+// drain before giving up. A negative duration waits indefinitely.
+func (n *Negroni) SetShutdownTimeout(d time.Duration) {
+	n.shutdownTimeout = d
+}
+
+// RegisterOnShutdown registers a function to call when RunContext,
+// RunTLSContext or ServeContext shut the server down, once the in-flight
+// requests have drained or the shutdown timeout expired. It is meant to
+// release resources used by the handlers, e.g. to close an asynchronous
+// Logger so that its queued entries are written:
+//
+//	n.RegisterOnShutdown(logger.Close)
+func (n *Negroni) RegisterOnShutdown(f func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.onShutdown = append(n.onShutdown, f)
+}
+
+func (n *Negroni) callOnShutdown() {
+	n.mu.Lock()
+	onShutdown := n.onShutdown
+	n.mu.Unlock()
+	for _, f := range onShutdown {
+		f()
+	}
+}
+
+// RunContext runs the negroni stack as an HTTP server like Run, but shuts the
+// server down gracefully instead of exiting the process. The server stops
+// accepting new connections when ctx is done or the process receives SIGINT or
+// SIGTERM, and in-flight requests are given up to the shutdown timeout to
+// complete (see SetShutdownTimeout).
+//
+// RunContext returns nil after a graceful shutdown, or the error that stopped
+// the server otherwise.
+func (n *Negroni) RunContext(ctx context.Context, addr ...string) error {
+	l := log.New(os.Stdout, "[negroni] ", 0)
+	finalAddr := detectAddress(addr...)
+	srv, err := n.newServer(finalAddr)
+	if err != nil {
+		return err
+	}
+	ln, err := listen(finalAddr)
+	if err != nil {
+		return err
+	}
+	l.Printf("listening on %s", finalAddr)
+	return n.serveContext(ctx, srv, func() error {
+		return srv.Serve(ln)
+	})
+}
+
+// Serve serves the negroni stack over HTTP on the given listener, such as a
+// Unix domain socket or a socket inherited from a parent process. It shuts
+// down gracefully like RunContext when the process receives SIGINT or SIGTERM.
+func (n *Negroni) Serve(l net.Listener) error {
+	return n.ServeContext(context.Background(), l)
+}
+
+// ServeContext is like Serve but also shuts the server down gracefully when
+// ctx is done.
+func (n *Negroni) ServeContext(ctx context.Context, l net.Listener) error {
+	srv, err := n.newServer(l.Addr().String())
+	if err != nil {
+		return err
+	}
+	return n.serveContext(ctx, srv, func() error {
+		return srv.Serve(l)
+	})
+}
+
+// RunTLS is like Run but serves HTTPS, with HTTP/2 negotiated automatically.
+// The certFile and keyFile arguments take the same format as
+// http.ListenAndServeTLS. They may be empty if the TLS configuration set with
+// SetTLSConfig already provides certificates.
+func (n *Negroni) RunTLS(certFile, keyFile string, addr ...string) {
+	l := log.New(os.Stdout, "[negroni] ", 0)
+	finalAddr := detectAddress(addr...)
+	srv, err := n.newServer(finalAddr)
+	if err != nil {
+		l.Fatal(err)
+	}
+	ln, err := listen(finalAddr)
+	if err != nil {
+		l.Fatal(err)
+	}
+	l.Printf("listening on %s (TLS)", finalAddr)
+	l.Fatal(srv.ServeTLS(ln, certFile, keyFile))
+}
+
+// RunTLSContext is like RunContext but serves HTTPS. See RunTLS for the
+// meaning of certFile and keyFile.
+func (n *Negroni) RunTLSContext(ctx context.Context, certFile, keyFile string, addr ...string) error {
+	l := log.New(os.Stdout, "[negroni] ", 0)
+	finalAddr := detectAddress(addr...)
+	srv, err := n.newServer(finalAddr)
+	if err != nil {
+		return err
+	}
+	ln, err := listen(finalAddr)
+	if err != nil {
+		return err
+	}
+	l.Printf("listening on %s (TLS)", finalAddr)
+	return n.serveContext(ctx, srv, func() error {
+		return srv.ServeTLS(ln, certFile, keyFile)
+	})
+}
+
+// SetTLSConfig sets the TLS configuration used by RunTLS and RunTLSContext.
+// Use a CertReloader as the GetCertificate callback to pick up renewed
+// certificates without restarting the server.
+func (n *Negroni) SetTLSConfig(config *tls.Config) {
+	n.tlsConfig = config
+}
+
+// EnableH2C enables or disables cleartext HTTP/2 (h2c) with prior knowledge
+// for the plaintext servers started by Run and RunContext, alongside HTTP/1.1.
+// This is useful behind proxies or service meshes that terminate TLS and speak
+// HTTP/2 to the upstream. h2c requires Go 1.24 or later.
+func (n *Negroni) EnableH2C(enabled bool) {
+	n.h2c = enabled
+}
+
+func (n *Negroni) newServer(addr string) (*http.Server, error) {
+	srv := &http.Server{
+		Addr:      addr,
+		Handler:   n,
+		TLSConfig: n.tlsConfig,
+	}
+	if n.h2c {
+		if err := enableH2C(srv); err != nil {
+			return nil, err
+		}
+	}
+	return srv, nil
+}
+
+func (n *Negroni) serveContext(ctx context.Context, srv *http.Server, serve func() error) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- serve()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	defer n.callOnShutdown()
+
+	shutdownCtx := context.Background()
+	if timeout := n.drainTimeout(); timeout >= 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, timeout)
+		defer cancel()
+	}
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errc; err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (n *Negroni) drainTimeout() time.Duration {
+	if n.shutdownTimeout == 0 {
+		return DefaultShutdownTimeout
+	}
+	return n.shutdownTimeout
+}
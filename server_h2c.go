@@ -0,0 +1,15 @@
+//go:build go1.24
+// +build go1.24
+
+package negroni
+
+import "net/http"
+
+func enableH2C(srv *http.Server) error {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	srv.Protocols = protocols
+	return nil
+}
@@ -0,0 +1,40 @@
+//go:build go1.24
+// +build go1.24
+
+package negroni
+
+import (
+	"net"
+	"net/http"
+	"testing"
+)
+
+func TestNegroniEnableH2C(t *testing.T) {
+	n := New()
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(r.Proto))
+	})
+	n.EnableH2C(true)
+
+	srv, err := n.newServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(ln)
+	defer srv.Close()
+
+	protocols := new(http.Protocols)
+	protocols.SetUnencryptedHTTP2(true)
+	client := &http.Client{Transport: &http.Transport{Protocols: protocols}}
+
+	res, err := client.Get("http://" + ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	expect(t, res.ProtoMajor, 2)
+}
@@ -0,0 +1,136 @@
+package negroni
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNegroniRunContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- New().RunContext(ctx, "127.0.0.1:0")
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		expect(t, err, nil)
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunContext did not return after the context was cancelled")
+	}
+}
+
+func TestNegroniRunContext_listenError(t *testing.T) {
+	err := New().RunContext(context.Background(), "127.0.0.1:-1")
+	refute(t, err, nil)
+}
+
+func TestNegroniServeContext_drainsInFlightRequests(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := make(chan struct{})
+	n := New()
+	n.UseHandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(100 * time.Millisecond)
+		rw.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := &http.Server{Handler: n}
+	done := make(chan error, 1)
+	go func() {
+		done <- n.serveContext(ctx, srv, func() error { return srv.Serve(ln) })
+	}()
+
+	resc := make(chan *http.Response, 1)
+	go func() {
+		res, err := http.Get("http://" + ln.Addr().String())
+		if err != nil {
+			t.Error(err)
+			resc <- nil
+			return
+		}
+		res.Body.Close()
+		resc <- res
+	}()
+
+	<-started
+	cancel()
+
+	if res := <-resc; res != nil {
+		expect(t, res.StatusCode, http.StatusAccepted)
+	}
+	expect(t, <-done, nil)
+}
+
+func TestNegroniDrainTimeout(t *testing.T) {
+	n := New()
+	expect(t, n.drainTimeout(), DefaultShutdownTimeout)
+
+	n.SetShutdownTimeout(time.Second)
+	expect(t, n.drainTimeout(), time.Second)
+}
+
+func TestNegroniRunTLSContext(t *testing.T) {
+	certFile, keyFile := writeTestCert(t, t.TempDir(), "localhost")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- New().RunTLSContext(ctx, certFile, keyFile, "127.0.0.1:0")
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		expect(t, err, nil)
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunTLSContext did not return after the context was cancelled")
+	}
+}
+
+func TestNegroniNewServer(t *testing.T) {
+	config := &tls.Config{}
+	n := New()
+	n.SetTLSConfig(config)
+
+	srv, err := n.newServer(":8443")
+	expect(t, err, nil)
+	expect(t, srv.Addr, ":8443")
+	expect(t, srv.TLSConfig, config)
+	expect(t, srv.Handler, http.Handler(n))
+}
+
+func TestNegroniRegisterOnShutdown(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var calls []string
+	n := New()
+	n.RegisterOnShutdown(func() { calls = append(calls, "first") })
+	n.RegisterOnShutdown(func() { calls = append(calls, "second") })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- n.ServeContext(ctx, ln)
+	}()
+	expect(t, len(calls), 0)
+
+	cancel()
+	expect(t, <-done, nil)
+	expect(t, len(calls), 2)
+	expect(t, calls[0], "first")
+	expect(t, calls[1], "second")
+}
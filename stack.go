@@ -0,0 +1,137 @@
+package negroni
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+)
+
+// ErrHandlerNotFound is returned when a Target does not match any handler in
+// a Negroni middleware stack.
+var ErrHandlerNotFound = errors.New("negroni: handler not found")
+
+// Target selects a handler within a Negroni middleware stack. It is called
+// with the position and value of each handler in turn, and the first handler
+// it reports true for is selected.
+type Target func(i int, h Handler) bool
+
+// At returns a Target selecting the handler at index i.
+func At(i int) Target {
+	return func(j int, h Handler) bool {
+		return i == j
+	}
+}
+
+// ByType returns a Target selecting the first handler with the same dynamic
+// type as example, e.g. ByType(&negroni.Logger{}). Handlers wrapped by Named
+// are matched on the type of the handler they wrap.
+func ByType(example Handler) Target {
+	typ := reflect.TypeOf(unwrapHandler(example))
+	return func(i int, h Handler) bool {
+		return reflect.TypeOf(unwrapHandler(h)) == typ
+	}
+}
+
+// ByName returns a Target selecting the first NamedHandler called name, such
+// as a handler wrapped with Named.
+func ByName(name string) Target {
+	return func(i int, h Handler) bool {
+		named, ok := h.(NamedHandler)
+		return ok && named.Name() == name
+	}
+}
+
+// Named wraps handler with a name, so it can later be found in a stack with
+// ByName.
+func Named(name string, handler Handler) Handler {
+	if handler == nil {
+		panic("handler cannot be nil")
+	}
+	return &namedHandler{name: name, handler: handler}
+}
+
+type namedHandler struct {
+	name    string
+	handler Handler
+}
+
+func (h *namedHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	h.handler.ServeHTTP(rw, r, next)
+}
+
+// Name returns the name the handler was given.
+func (h *namedHandler) Name() string {
+	return h.name
+}
+
+// Unwrap returns the wrapped handler.
+func (h *namedHandler) Unwrap() Handler {
+	return h.handler
+}
+
+func unwrapHandler(h Handler) Handler {
+	for {
+		u, ok := h.(interface{ Unwrap() Handler })
+		if !ok {
+			return h
+		}
+		h = u.Unwrap()
+	}
+}
+
+// InsertBefore inserts handlers into the middleware stack right before the
+// handler selected by target.
+func (n *Negroni) InsertBefore(target Target, handlers ...Handler) error {
+	return n.edit(target, func(i int) []Handler {
+		return splice(n.handlers, i, i, handlers...)
+	}, handlers...)
+}
+
+// InsertAfter inserts handlers into the middleware stack right after the
+// handler selected by target.
+func (n *Negroni) InsertAfter(target Target, handlers ...Handler) error {
+	return n.edit(target, func(i int) []Handler {
+		return splice(n.handlers, i+1, i+1, handlers...)
+	}, handlers...)
+}
+
+// Replace replaces the handler selected by target with handler.
+func (n *Negroni) Replace(target Target, handler Handler) error {
+	return n.edit(target, func(i int) []Handler {
+		return splice(n.handlers, i, i+1, handler)
+	}, handler)
+}
+
+// Remove removes the handler selected by target from the middleware stack.
+func (n *Negroni) Remove(target Target) error {
+	return n.edit(target, func(i int) []Handler {
+		return splice(n.handlers, i, i+1)
+	})
+}
+
+func (n *Negroni) edit(target Target, update func(i int) []Handler, added ...Handler) error {
+	for _, handler := range added {
+		if handler == nil {
+			panic("handler cannot be nil")
+		}
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for i, h := range n.handlers {
+		if target(i, h) {
+			n.setHandlers(update(i))
+			return nil
+		}
+	}
+	return ErrHandlerNotFound
+}
+
+// splice returns a new slice with handlers[from:to] replaced by inserted,
+// leaving handlers itself untouched.
+func splice(handlers []Handler, from, to int, inserted ...Handler) []Handler {
+	result := make([]Handler, 0, len(handlers)-(to-from)+len(inserted))
+	result = append(result, handlers[:from]...)
+	result = append(result, inserted...)
+	return append(result, handlers[to:]...)
+}
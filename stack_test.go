@@ -0,0 +1,79 @@
+package negroni
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type markHandler struct {
+	result *string
+	mark   string
+}
+
+func (h *markHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	*h.result += h.mark
+	next(rw, r)
+}
+
+func TestNegroniStackEditing(t *testing.T) {
+	result := ""
+	mark := func(s string) Handler {
+		return HandlerFunc(func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+			result += s
+			next(rw, r)
+		})
+	}
+	serve := func(n *Negroni) string {
+		result = ""
+		n.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+		return result
+	}
+
+	n := New(mark("a"), Named("b", mark("b")), &markHandler{result: &result, mark: "c"})
+	expect(t, serve(n), "abc")
+
+	expect(t, n.InsertBefore(At(0), mark("1")), nil)
+	expect(t, serve(n), "1abc")
+
+	expect(t, n.InsertAfter(ByName("b"), mark("2"), mark("3")), nil)
+	expect(t, serve(n), "1ab23c")
+
+	expect(t, n.Replace(ByType(&markHandler{}), mark("C")), nil)
+	expect(t, serve(n), "1ab23C")
+
+	expect(t, n.Remove(ByName("b")), nil)
+	expect(t, serve(n), "1a23C")
+	expect(t, len(n.Handlers()), 5)
+
+	expect(t, n.Remove(ByName("b")), ErrHandlerNotFound)
+	expect(t, n.Replace(At(5), mark("x")), ErrHandlerNotFound)
+	expect(t, serve(n), "1a23C")
+}
+
+func TestNegroniStackEditing_doNotModifyWith(t *testing.T) {
+	result := ""
+	mark := func(s string) Handler {
+		return &markHandler{result: &result, mark: s}
+	}
+
+	n1 := New(mark("a"), mark("b"))
+	n2 := n1.With(mark("c"))
+	expect(t, n1.Remove(At(0)), nil)
+
+	n2.ServeHTTP(httptest.NewRecorder(), (*http.Request)(nil))
+	expect(t, result, "abc")
+}
+
+func TestByTypeSeesThroughNamed(t *testing.T) {
+	target := ByType(&Logger{})
+	expect(t, target(0, Named("logger", NewLogger())), true)
+	expect(t, target(0, NewRecovery()), false)
+}
+
+func TestNegroniHandlersReturnsCopy(t *testing.T) {
+	n := New(NewRecovery())
+	n.Handlers()[0] = NewLogger()
+	_, ok := n.Handlers()[0].(*Recovery)
+	expect(t, ok, true)
+}
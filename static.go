@@ -18,6 +18,10 @@ type Static struct {
 	Prefix string
 	// IndexFile defines which file to serve as index if it exists.
 	IndexFile string
+	// EarlyHints optionally returns the Link header values to announce in a
+	// 103 Early Hints response before serving the named file, e.g. to
+	// preload the assets of an HTML page. See the EarlyHints function.
+	EarlyHints func(name string) []string
 }
 
 // NewStatic returns a new instance of Static
@@ -87,5 +91,8 @@ func (s *Static) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.Ha
 		}
 	}
 
+	if s.EarlyHints != nil {
+		EarlyHints(rw, s.EarlyHints(file))
+	}
 	http.ServeContent(rw, r, file, fi.ModTime(), f)
 }
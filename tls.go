@@ -0,0 +1,97 @@
+package negroni
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+)
+
+// CertReloader loads a TLS certificate and key pair from disk and reloads it
+// whenever either file changes, so renewed certificates are served without
+// restarting the server. Its GetCertificate method is meant to be used as the
+// tls.Config.GetCertificate callback.
+type CertReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	certMod time.Time
+	keyMod  time.Time
+	lastErr error
+}
+
+// NewCertReloader returns a CertReloader for the given certificate and key
+// files. An error is returned if the initial pair cannot be loaded.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	c := &CertReloader{certFile: certFile, keyFile: keyFile}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// TLSConfig returns a new tls.Config that serves the reloaded certificate.
+func (c *CertReloader) TLSConfig() *tls.Config {
+	return &tls.Config{GetCertificate: c.GetCertificate}
+}
+
+// GetCertificate returns the current certificate, reloading it first if the
+// certificate or key file has been modified since it was last read. If a
+// reload fails, the previously loaded certificate keeps being served.
+func (c *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.changed() {
+		c.lastErr = c.reload()
+	}
+	return c.cert, nil
+}
+
+// Err returns the error of the most recent failed reload, if any.
+func (c *CertReloader) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastErr
+}
+
+func (c *CertReloader) changed() bool {
+	certMod, err := modTime(c.certFile)
+	if err != nil {
+		return false
+	}
+	keyMod, err := modTime(c.keyFile)
+	if err != nil {
+		return false
+	}
+	return !certMod.Equal(c.certMod) || !keyMod.Equal(c.keyMod)
+}
+
+func (c *CertReloader) reload() error {
+	certMod, err := modTime(c.certFile)
+	if err != nil {
+		return err
+	}
+	keyMod, err := modTime(c.keyFile)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return err
+	}
+	c.cert = &cert
+	c.certMod = certMod
+	c.keyMod = keyMod
+	return nil
+}
+
+func modTime(name string) (time.Time, error) {
+	fi, err := os.Stat(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fi.ModTime(), nil
+}